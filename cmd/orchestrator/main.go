@@ -18,18 +18,39 @@ import (
 
 var appFlags = []cli.Flag{
 	cmd.VanguardGRPCEndpoint,
+	cmd.VanguardGRPCCACertFlag,
+	cmd.VanguardGRPCClientCertFlag,
+	cmd.VanguardGRPCClientKeyFlag,
+	cmd.VanguardGRPCTokenFlag,
 	cmd.PandoraRPCEndpoint,
+	cmd.PandoraRPCNamespaceFlag,
+	cmd.PandoraJWTSecretFlag,
+	cmd.PandoraPollIntervalFlag,
+	cmd.CaptureFileFlag,
+	cmd.ReplayFileFlag,
 	cmd.VerbosityFlag,
 	cmd.IPCPathFlag,
 	cmd.HTTPEnabledFlag,
 	cmd.HTTPListenAddrFlag,
 	cmd.HTTPPortFlag,
+	cmd.HTTPCORSDomainFlag,
+	cmd.HTTPVirtualHostsFlag,
+	cmd.HTTPApiFlag,
+	cmd.HTTPPathPrefixFlag,
+	cmd.HTTPReadTimeoutFlag,
+	cmd.HTTPWriteTimeoutFlag,
+	cmd.HTTPIdleTimeoutFlag,
+	cmd.HTTPReadyMaxSlotLagFlag,
 	cmd.WSEnabledFlag,
 	cmd.WSListenAddrFlag,
 	cmd.WSPortFlag,
+	cmd.WSApiFlag,
+	cmd.WSAllowedOriginsFlag,
+	cmd.WSPathPrefixFlag,
 	cmd.DataDirFlag,
 	cmd.ClearDB,
 	cmd.ForceClearDB,
+	cmd.DBBackendFlag,
 	cmd.LogFileName,
 	cmd.LogFormat,
 }
@@ -45,6 +45,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.VerbosityFlag,
 			cmd.ForceClearDB,
 			cmd.ClearDB,
+			cmd.DBBackendFlag,
 			cmd.BoltMMapInitialSizeFlag,
 		},
 	},
@@ -55,11 +56,31 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.HTTPEnabledFlag,
 			cmd.HTTPListenAddrFlag,
 			cmd.HTTPPortFlag,
+			cmd.HTTPCORSDomainFlag,
+			cmd.HTTPVirtualHostsFlag,
+			cmd.HTTPApiFlag,
+			cmd.HTTPPathPrefixFlag,
+			cmd.HTTPReadTimeoutFlag,
+			cmd.HTTPWriteTimeoutFlag,
+			cmd.HTTPIdleTimeoutFlag,
+			cmd.HTTPReadyMaxSlotLagFlag,
 			cmd.WSEnabledFlag,
 			cmd.WSListenAddrFlag,
 			cmd.WSPortFlag,
+			cmd.WSApiFlag,
+			cmd.WSAllowedOriginsFlag,
+			cmd.WSPathPrefixFlag,
 			cmd.VanguardGRPCEndpoint,
+			cmd.VanguardGRPCCACertFlag,
+			cmd.VanguardGRPCClientCertFlag,
+			cmd.VanguardGRPCClientKeyFlag,
+			cmd.VanguardGRPCTokenFlag,
 			cmd.PandoraRPCEndpoint,
+			cmd.PandoraRPCNamespaceFlag,
+			cmd.PandoraJWTSecretFlag,
+			cmd.PandoraPollIntervalFlag,
+			cmd.CaptureFileFlag,
+			cmd.ReplayFileFlag,
 		},
 	},
 	{
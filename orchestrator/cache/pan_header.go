@@ -53,6 +53,11 @@ func (c *PanHeaderCache) Remove(ctx context.Context, slot uint64) {
 	}
 }
 
+// Len returns the number of pending headers
+func (c *PanHeaderCache) Len() int {
+	return c.cache.Len()
+}
+
 func (c *PanHeaderCache) GetAll() ([]*eth1Types.Header, error) {
 	keys := c.cache.Keys()
 	pendingHeaders := make([]*eth1Types.Header, 0)
@@ -48,3 +48,8 @@ func (vc *VanShardingInfoCache) Remove(ctx context.Context, slot uint64) {
 		}
 	}
 }
+
+// Len returns the number of pending sharding infos
+func (vc *VanShardingInfoCache) Len() int {
+	return vc.cache.Len()
+}
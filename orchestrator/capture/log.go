@@ -0,0 +1,5 @@
+package capture
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "capture")
@@ -0,0 +1,69 @@
+package capture
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+// pandoraReplayService serves the captured pandora headers over the pending headers subscription
+type pandoraReplayService struct {
+	player *Player
+}
+
+// NewPendingBlockHeaders streams the captured headers. The filter is ignored because the headers are
+// replayed as received.
+func (s *pandoraReplayService) NewPendingBlockHeaders(
+	ctx context.Context,
+	filter types.PandoraPendingHeaderFilter,
+) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	subscription := notifier.CreateSubscription()
+
+	// request context is cancelled once the subscription is created, so the replay has its own context
+	subCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		defer cancel()
+		select {
+		case <-subscription.Err():
+		case <-notifier.Closed():
+		}
+	}()
+
+	go func() {
+		for {
+			record, err := s.player.next(subCtx, KindPandoraHeader)
+			if err != nil {
+				return
+			}
+			header, err := record.PandoraHeader()
+			if err != nil {
+				log.WithError(err).Error("Could not replay captured pandora header")
+				continue
+			}
+			if err := notifier.Notify(subscription.ID, header); err != nil {
+				log.WithError(err).Warn("Could not send replayed pandora header")
+				return
+			}
+		}
+	}()
+	return subscription, nil
+}
+
+// NewPandoraDialer returns the dial function of pandora chain service which ignores the endpoint and
+// connects to an in-process server that replays the capture under the given namespace.
+func NewPandoraDialer(player *Player, namespace string) (func(endpoint string) (*rpc.Client, error), error) {
+	server := rpc.NewServer()
+	if err := server.RegisterName(namespace, &pandoraReplayService{player: player}); err != nil {
+		return nil, errors.Wrap(err, "could not register pandora replay service")
+	}
+	return func(endpoint string) (*rpc.Client, error) {
+		log.WithField("endpoint", endpoint).Info("Replaying captured pandora headers instead of dialing pandora node")
+		return rpc.DialInProc(server), nil
+	}, nil
+}
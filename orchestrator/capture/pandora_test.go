@@ -0,0 +1,47 @@
+package capture
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+func TestNewPandoraDialer_ReplaysHeaders(t *testing.T) {
+	startTime := time.Now()
+	headers := []*eth1Types.Header{testutil.NewEth1Header(1), testutil.NewEth1Header(2)}
+	player, err := NewPlayer(writeCapture(t,
+		mustRecord(t, KindPandoraHeader, headers[0], startTime),
+		mustRecord(t, KindBeaconBlock, newBeaconBlock(1), startTime),
+		mustRecord(t, KindPandoraHeader, headers[1], startTime.Add(100*time.Millisecond)),
+	))
+	require.NoError(t, err)
+
+	dial, err := NewPandoraDialer(player, "eth")
+	require.NoError(t, err)
+	rpcClient, err := dial("ws://127.0.0.1:8546")
+	require.NoError(t, err)
+	defer rpcClient.Close()
+
+	ch := make(chan *eth1Types.Header)
+	sub, err := rpcClient.Subscribe(context.Background(), "eth", ch, "newPendingBlockHeaders",
+		&types.PandoraPendingHeaderFilter{})
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	for _, header := range headers {
+		select {
+		case replayedHeader := <-ch:
+			assert.Equal(t, header.Hash(), replayedHeader.Hash())
+		case err := <-sub.Err():
+			t.Fatal(err)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for replayed pandora header")
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package capture
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var errEmptyCapture = errors.New("capture file has no records")
+
+// Player feeds the records of a capture file to the replay clients. Records of a kind are handed out
+// in arrival order exactly once, so resubscriptions continue where the previous stream stopped. Each
+// record is released after the same delay from the replay start as it was received after the capture start.
+type Player struct {
+	lock            sync.Mutex
+	records         map[Kind][]*Record
+	cursors         map[Kind]int
+	firstRecordTime time.Time
+	startTime       time.Time
+}
+
+// NewPlayer reads all the records of the capture file
+func NewPlayer(path string) (*Player, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open capture file")
+	}
+	defer file.Close()
+
+	player := &Player{
+		records: make(map[Kind][]*Record),
+		cursors: make(map[Kind]int),
+	}
+	decoder := json.NewDecoder(file)
+	for count := 0; ; count++ {
+		record := new(Record)
+		if err := decoder.Decode(record); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, errors.Wrapf(err, "could not decode capture record %d", count)
+		}
+		if count == 0 || record.Time.Before(player.firstRecordTime) {
+			player.firstRecordTime = record.Time
+		}
+		player.records[record.Kind] = append(player.records[record.Kind], record)
+	}
+	if len(player.records) == 0 {
+		return nil, errEmptyCapture
+	}
+	for kind, records := range player.records {
+		log.WithField("kind", kind).WithField("records", len(records)).Debug("Loaded captured records")
+	}
+	return player, nil
+}
+
+// Len returns the number of captured records of the given kind
+func (p *Player) Len(kind Kind) int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return len(p.records[kind])
+}
+
+// next waits until the next record of the given kind is due and returns it. When every record of the
+// kind has been replayed, it blocks until the context is done, like a live stream without new messages.
+func (p *Player) next(ctx context.Context, kind Kind) (*Record, error) {
+	p.lock.Lock()
+	if p.startTime.IsZero() {
+		p.startTime = time.Now()
+	}
+	records, cursor := p.records[kind], p.cursors[kind]
+	if cursor >= len(records) {
+		p.lock.Unlock()
+		log.WithField("kind", kind).WithField("records", len(records)).Debug("Replayed all captured records")
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	record := records[cursor]
+	p.cursors[kind] = cursor + 1
+	due := p.startTime.Add(record.Time.Sub(p.firstRecordTime))
+	p.lock.Unlock()
+
+	if wait := time.Until(due); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			p.rewind(kind, cursor)
+			return nil, ctx.Err()
+		}
+	}
+	return record, nil
+}
+
+// rewind hands the record at the given cursor out again if it has not been delivered because its
+// stream was closed while waiting.
+func (p *Player) rewind(kind Kind, cursor int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.cursors[kind] == cursor+1 {
+		p.cursors[kind] = cursor
+	}
+}
@@ -0,0 +1,87 @@
+package capture
+
+import (
+	"encoding/json"
+	"time"
+
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Kind tells which stream a captured record was received from
+type Kind string
+
+const (
+	KindBeaconBlock   Kind = "beaconBlock"
+	KindConsensusInfo Kind = "consensusInfo"
+	KindPandoraHeader Kind = "pandoraHeader"
+)
+
+var errUnexpectedKind = errors.New("unexpected record kind")
+
+// Record is a single line of the capture file. Data holds the received message as json.
+type Record struct {
+	Kind Kind            `json:"kind"`
+	Time time.Time       `json:"time"`
+	Data json.RawMessage `json:"data"`
+}
+
+// newRecord encodes the message of the given kind. Vanguard messages are encoded with protojson.
+func newRecord(kind Kind, msg interface{}, arrivedAt time.Time) (*Record, error) {
+	var (
+		data []byte
+		err  error
+	)
+	switch kind {
+	case KindBeaconBlock:
+		data, err = protojson.Marshal(msg.(*eth.BeaconBlock))
+	case KindConsensusInfo:
+		data, err = protojson.Marshal(msg.(*eth.MinimalConsensusInfo))
+	case KindPandoraHeader:
+		data, err = json.Marshal(msg.(*eth1Types.Header))
+	default:
+		return nil, errors.Wrapf(errUnexpectedKind, "kind %q", kind)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not encode %s", kind)
+	}
+	return &Record{Kind: kind, Time: arrivedAt, Data: data}, nil
+}
+
+// BeaconBlock decodes the record as vanguard beacon block
+func (r *Record) BeaconBlock() (*eth.BeaconBlock, error) {
+	if r.Kind != KindBeaconBlock {
+		return nil, errors.Wrapf(errUnexpectedKind, "got %q, want %q", r.Kind, KindBeaconBlock)
+	}
+	block := new(eth.BeaconBlock)
+	if err := protojson.Unmarshal(r.Data, block); err != nil {
+		return nil, errors.Wrap(err, "could not decode beacon block")
+	}
+	return block, nil
+}
+
+// ConsensusInfo decodes the record as vanguard minimal consensus info
+func (r *Record) ConsensusInfo() (*eth.MinimalConsensusInfo, error) {
+	if r.Kind != KindConsensusInfo {
+		return nil, errors.Wrapf(errUnexpectedKind, "got %q, want %q", r.Kind, KindConsensusInfo)
+	}
+	consensusInfo := new(eth.MinimalConsensusInfo)
+	if err := protojson.Unmarshal(r.Data, consensusInfo); err != nil {
+		return nil, errors.Wrap(err, "could not decode consensus info")
+	}
+	return consensusInfo, nil
+}
+
+// PandoraHeader decodes the record as pandora header
+func (r *Record) PandoraHeader() (*eth1Types.Header, error) {
+	if r.Kind != KindPandoraHeader {
+		return nil, errors.Wrapf(errUnexpectedKind, "got %q, want %q", r.Kind, KindPandoraHeader)
+	}
+	header := new(eth1Types.Header)
+	if err := json.Unmarshal(r.Data, header); err != nil {
+		return nil, errors.Wrap(err, "could not decode pandora header")
+	}
+	return header, nil
+}
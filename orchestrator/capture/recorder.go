@@ -0,0 +1,97 @@
+package capture
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// Recorder appends every received vanguard block, consensus info and pandora header with its
+// arrival time to the capture file. A nil recorder records nothing, so services can call it unconditionally.
+type Recorder struct {
+	lock    sync.Mutex
+	file    *os.File
+	writer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+// NewRecorder opens the capture file. An existing capture is overwritten, because the player replays
+// the records relative to the first one and records of an earlier run would replay the gap between the runs.
+func NewRecorder(path string) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open capture file")
+	}
+	writer := bufio.NewWriter(file)
+	return &Recorder{
+		file:    file,
+		writer:  writer,
+		encoder: json.NewEncoder(writer),
+	}, nil
+}
+
+// RecordBeaconBlock
+func (r *Recorder) RecordBeaconBlock(block *eth.BeaconBlock) {
+	r.record(KindBeaconBlock, block)
+}
+
+// RecordConsensusInfo
+func (r *Recorder) RecordConsensusInfo(consensusInfo *eth.MinimalConsensusInfo) {
+	r.record(KindConsensusInfo, consensusInfo)
+}
+
+// RecordPandoraHeader
+func (r *Recorder) RecordPandoraHeader(header *eth1Types.Header) {
+	r.record(KindPandoraHeader, header)
+}
+
+// record writes the message and flushes it right away so that the capture survives a crash.
+// Recording failures are only logged, they must not stop the node.
+func (r *Recorder) record(kind Kind, msg interface{}) {
+	if r == nil {
+		return
+	}
+	record, err := newRecord(kind, msg, time.Now())
+	if err != nil {
+		log.WithError(err).Warn("Could not capture received message")
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.file == nil {
+		return
+	}
+	if err := r.encoder.Encode(record); err != nil {
+		log.WithError(err).WithField("kind", kind).Warn("Could not write capture record")
+		return
+	}
+	if err := r.writer.Flush(); err != nil {
+		log.WithError(err).WithField("kind", kind).Warn("Could not flush capture file")
+	}
+}
+
+// Close flushes and closes the capture file
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	file := r.file
+	r.file = nil
+	if err := r.writer.Flush(); err != nil {
+		file.Close()
+		return errors.Wrap(err, "could not flush capture file")
+	}
+	return file.Close()
+}
@@ -0,0 +1,133 @@
+package capture
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	types "github.com/prysmaticlabs/eth2-types"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// newBeaconBlock
+func newBeaconBlock(slot uint64) *eth.BeaconBlock {
+	return &eth.BeaconBlock{
+		Slot:       types.Slot(slot),
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		Body: &eth.BeaconBlockBody{
+			PandoraShard: []*eth.PandoraShard{testutil.NewPandoraShard(testutil.NewEth1Header(slot))},
+		},
+	}
+}
+
+// newConsensusInfo
+func newConsensusInfo(epoch uint64) *eth.MinimalConsensusInfo {
+	consensusInfo := testutil.NewMinimalConsensusInfo(epoch)
+	return &eth.MinimalConsensusInfo{
+		Epoch:            types.Epoch(epoch),
+		ValidatorList:    consensusInfo.ValidatorList,
+		EpochTimeStart:   consensusInfo.EpochStartTime,
+		SlotTimeDuration: &duration.Duration{Seconds: 6},
+	}
+}
+
+// writeCapture writes the given records to a capture file in a temporary directory
+func writeCapture(t *testing.T, records ...*Record) string {
+	path := filepath.Join(t.TempDir(), "capture.jsonl")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+	encoder := json.NewEncoder(file)
+	for _, record := range records {
+		require.NoError(t, encoder.Encode(record))
+	}
+	return path
+}
+
+// mustRecord
+func mustRecord(t *testing.T, kind Kind, msg interface{}, arrivedAt time.Time) *Record {
+	record, err := newRecord(kind, msg, arrivedAt)
+	require.NoError(t, err)
+	return record
+}
+
+func TestRecorder_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "capture.jsonl")
+	recorder, err := NewRecorder(path)
+	require.NoError(t, err)
+
+	header := testutil.NewEth1Header(3)
+	recorder.RecordBeaconBlock(newBeaconBlock(3))
+	recorder.RecordConsensusInfo(newConsensusInfo(1))
+	recorder.RecordPandoraHeader(header)
+	recorder.RecordBeaconBlock(newBeaconBlock(4))
+	require.NoError(t, recorder.Close())
+	// recording after close is ignored
+	recorder.RecordBeaconBlock(newBeaconBlock(5))
+
+	player, err := NewPlayer(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, player.Len(KindBeaconBlock))
+	assert.Equal(t, 1, player.Len(KindConsensusInfo))
+	assert.Equal(t, 1, player.Len(KindPandoraHeader))
+
+	block, err := player.records[KindBeaconBlock][1].BeaconBlock()
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(4), block.Slot)
+	assert.DeepEqual(t, newBeaconBlock(4).Body.PandoraShard[0].Hash, block.Body.PandoraShard[0].Hash)
+
+	consensusInfo, err := player.records[KindConsensusInfo][0].ConsensusInfo()
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), consensusInfo.Epoch)
+	assert.DeepEqual(t, newConsensusInfo(1).ValidatorList, consensusInfo.ValidatorList)
+	assert.Equal(t, int64(6), consensusInfo.SlotTimeDuration.Seconds)
+
+	replayedHeader, err := player.records[KindPandoraHeader][0].PandoraHeader()
+	require.NoError(t, err)
+	assert.Equal(t, header.Hash(), replayedHeader.Hash())
+
+	_, err = player.records[KindPandoraHeader][0].BeaconBlock()
+	assert.ErrorContains(t, errUnexpectedKind.Error(), err)
+}
+
+func TestRecorder_OverwritesPreviousRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "capture.jsonl")
+	recorder, err := NewRecorder(path)
+	require.NoError(t, err)
+	recorder.RecordBeaconBlock(newBeaconBlock(1))
+	recorder.RecordBeaconBlock(newBeaconBlock(2))
+	require.NoError(t, recorder.Close())
+
+	recorder, err = NewRecorder(path)
+	require.NoError(t, err)
+	recorder.RecordBeaconBlock(newBeaconBlock(3))
+	require.NoError(t, recorder.Close())
+
+	player, err := NewPlayer(path)
+	require.NoError(t, err)
+	require.Equal(t, 1, player.Len(KindBeaconBlock))
+	block, err := player.records[KindBeaconBlock][0].BeaconBlock()
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(3), block.Slot)
+}
+
+func TestRecorder_NilRecorder(t *testing.T) {
+	var recorder *Recorder
+	recorder.RecordBeaconBlock(newBeaconBlock(1))
+	assert.NoError(t, recorder.Close())
+}
+
+func TestNewPlayer_EmptyCapture(t *testing.T) {
+	_, err := NewPlayer(writeCapture(t))
+	assert.ErrorContains(t, errEmptyCapture.Error(), err)
+
+	_, err = NewPlayer(filepath.Join(t.TempDir(), "missing.jsonl"))
+	assert.ErrorContains(t, "could not open capture file", err)
+}
@@ -0,0 +1,168 @@
+package capture
+
+import (
+	"context"
+	"sync"
+
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/vanguardchain/client"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var errReplayStream = errors.New("replay stream is receive only")
+
+// Assure that replayVanguardClient struct will implement VanguardClient interface
+var _ client.VanguardClient = &replayVanguardClient{}
+
+// replayVanguardClient serves captured vanguard blocks and consensus infos instead of a vanguard node.
+// Historical blocks are part of the captured block stream, so it reports the latest replayed slot
+// as canonical head and has no canonical blocks to backfill.
+type replayVanguardClient struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	player *Player
+
+	lock     sync.RWMutex
+	headSlot types.Slot
+}
+
+// NewVanguardDialer returns the dial function of vanguard chain service which ignores the endpoint
+// and replays the capture instead.
+func NewVanguardDialer(ctx context.Context, player *Player) func(endpoint string) (client.VanguardClient, error) {
+	return func(endpoint string) (client.VanguardClient, error) {
+		clientCtx, cancel := context.WithCancel(ctx)
+		log.WithField("endpoint", endpoint).Info("Replaying captured vanguard streams instead of dialing vanguard node")
+		return &replayVanguardClient{ctx: clientCtx, cancel: cancel, player: player}, nil
+	}
+}
+
+// CanonicalHeadSlot returns the slot of the latest replayed block
+func (c *replayVanguardClient) CanonicalHeadSlot() (types.Slot, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.headSlot, nil
+}
+
+// CanonicalBlocksByEpoch
+func (c *replayVanguardClient) CanonicalBlocksByEpoch(epoch uint64) ([]*eth.BeaconBlock, error) {
+	return []*eth.BeaconBlock{}, nil
+}
+
+// StreamNewPendingBlocks streams the captured blocks. The blocks are replayed as received, so the
+// starting point is ignored.
+func (c *replayVanguardClient) StreamNewPendingBlocks(
+	blockRoot []byte,
+	fromSlot types.Slot,
+) (eth.BeaconChain_StreamNewPendingBlocksClient, error) {
+	return &replayBlockStream{replayStream{c.ctx}, c}, nil
+}
+
+// StreamMinimalConsensusInfo streams the captured consensus infos, including the backfilled ones,
+// in the order they were received. The starting epoch is ignored. The stream ends when either ctx is
+// done or the client is closed.
+func (c *replayVanguardClient) StreamMinimalConsensusInfo(
+	ctx context.Context,
+	epoch uint64,
+) (eth.BeaconChain_StreamMinimalConsensusInfoClient, error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+		select {
+		case <-c.ctx.Done():
+		case <-streamCtx.Done():
+		}
+	}()
+	return &replayConsensusInfoStream{replayStream{streamCtx}, c.player}, nil
+}
+
+// Close stops the streams of the client
+func (c *replayVanguardClient) Close() {
+	c.cancel()
+}
+
+// setHeadSlot
+func (c *replayVanguardClient) setHeadSlot(slot types.Slot) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if slot > c.headSlot {
+		c.headSlot = slot
+	}
+}
+
+// replayStream implements the client side of a receive only grpc stream
+type replayStream struct {
+	ctx context.Context
+}
+
+func (s *replayStream) Header() (metadata.MD, error) {
+	return metadata.MD{}, nil
+}
+
+func (s *replayStream) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+func (s *replayStream) CloseSend() error {
+	return nil
+}
+
+func (s *replayStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *replayStream) SendMsg(m interface{}) error {
+	return errReplayStream
+}
+
+func (s *replayStream) RecvMsg(m interface{}) error {
+	return errReplayStream
+}
+
+// toStatusErr converts replay errors into grpc status errors, as vanguard subscriptions expect them
+func toStatusErr(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// replayBlockStream
+type replayBlockStream struct {
+	replayStream
+	client *replayVanguardClient
+}
+
+func (s *replayBlockStream) Recv() (*eth.BeaconBlock, error) {
+	record, err := s.client.player.next(s.ctx, KindBeaconBlock)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	block, err := record.BeaconBlock()
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	s.client.setHeadSlot(block.Slot)
+	return block, nil
+}
+
+// replayConsensusInfoStream
+type replayConsensusInfoStream struct {
+	replayStream
+	player *Player
+}
+
+func (s *replayConsensusInfoStream) Recv() (*eth.MinimalConsensusInfo, error) {
+	record, err := s.player.next(s.ctx, KindConsensusInfo)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	consensusInfo, err := record.ConsensusInfo()
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	return consensusInfo, nil
+}
@@ -0,0 +1,87 @@
+package capture
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	types "github.com/prysmaticlabs/eth2-types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReplayVanguardClient_Streams(t *testing.T) {
+	startTime := time.Now()
+	player, err := NewPlayer(writeCapture(t,
+		mustRecord(t, KindConsensusInfo, newConsensusInfo(0), startTime),
+		mustRecord(t, KindBeaconBlock, newBeaconBlock(1), startTime),
+		mustRecord(t, KindBeaconBlock, newBeaconBlock(2), startTime.Add(300*time.Millisecond)),
+		mustRecord(t, KindConsensusInfo, newConsensusInfo(1), startTime.Add(300*time.Millisecond)),
+	))
+	require.NoError(t, err)
+
+	dial := NewVanguardDialer(context.Background(), player)
+	vanClient, err := dial("127.0.0.1:4000")
+	require.NoError(t, err)
+
+	blockStream, err := vanClient.StreamNewPendingBlocks(nil, 0)
+	require.NoError(t, err)
+	consensusInfoStream, err := vanClient.StreamMinimalConsensusInfo(context.Background(), 0)
+	require.NoError(t, err)
+
+	replayStart := time.Now()
+	block, err := blockStream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(1), block.Slot)
+	consensusInfo, err := consensusInfoStream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(0), consensusInfo.Epoch)
+
+	headSlot, err := vanClient.CanonicalHeadSlot()
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(1), headSlot)
+	blocks, err := vanClient.CanonicalBlocksByEpoch(0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blocks))
+
+	// resubscription continues with the next captured block and keeps the captured timing
+	vanClient.Close()
+	vanClient, err = dial("127.0.0.1:4000")
+	require.NoError(t, err)
+	blockStream, err = vanClient.StreamNewPendingBlocks(nil, 0)
+	require.NoError(t, err)
+	block, err = blockStream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(2), block.Slot)
+	assert.Equal(t, true, time.Since(replayStart) >= 250*time.Millisecond)
+
+	// stream waits for new messages after the replay until the client is closed
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		vanClient.Close()
+	}()
+	_, err = blockStream.Recv()
+	e, ok := status.FromError(err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, codes.Canceled, e.Code())
+}
+
+func TestPlayer_RewindsUndeliveredRecord(t *testing.T) {
+	startTime := time.Now()
+	player, err := NewPlayer(writeCapture(t,
+		mustRecord(t, KindBeaconBlock, newBeaconBlock(1), startTime),
+		mustRecord(t, KindBeaconBlock, newBeaconBlock(2), startTime.Add(time.Hour)),
+	))
+	require.NoError(t, err)
+
+	_, err = player.next(context.Background(), KindBeaconBlock)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = player.next(ctx, KindBeaconBlock)
+	assert.ErrorContains(t, context.DeadlineExceeded.Error(), err)
+	assert.Equal(t, 1, player.cursors[KindBeaconBlock])
+}
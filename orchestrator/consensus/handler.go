@@ -56,20 +56,12 @@ func (s *Service) verifyShardingInfo(slot uint64, vanShardInfo *types.VanguardSh
 		return nil
 	}
 
-	// store verified slot info into verified slot info bucket
-	if err := s.verifiedSlotInfoDB.SaveVerifiedSlotInfo(slot, slotInfo); err != nil {
+	// store verified slot info, latest verified slot and latest verified pandora header hash in one go
+	if err := s.verifiedSlotInfoDB.CommitVerifiedSlotInfo(slot, slotInfo); err != nil {
 		log.WithField("slot", slot).WithField(
 			"slotInfo", fmt.Sprintf("%+v", slotInfo)).WithError(err).Error("Failed to store verified slot info")
 		return err
 	}
-	// storing latest verified slot into db
-	if err := s.verifiedSlotInfoDB.SaveLatestVerifiedSlot(s.ctx); err != nil {
-		log.WithError(err).Error("Failed to store latest verified slot")
-	}
-	// storing latest verified pandora header hash into db
-	if err := s.verifiedSlotInfoDB.SaveLatestVerifiedHeaderHash(); err != nil {
-		log.WithError(err).Error("Failed to store latest verified slot")
-	}
 	slotInfoWithStatus.Status = types.Verified
 	//removing previous cached slots which dont verified yet. By convention, they are skipped
 	s.pandoraPendingHeaderCache.Remove(s.ctx, slot)
@@ -0,0 +1,67 @@
+package consensus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+var errInjectedCrash = errors.New("injected crash")
+
+// crashingVerifiedSlotInfoDB fails every verified slot info commit
+type crashingVerifiedSlotInfoDB struct {
+	db.VerifiedSlotInfoDB
+}
+
+func (c *crashingVerifiedSlotInfoDB) CommitVerifiedSlotInfo(slot uint64, slotInfo *types.SlotInfo) error {
+	return errInjectedCrash
+}
+
+func TestService_VerifyShardingInfo_Commit(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := setup(ctx, t)
+	headerInfos, shardInfos := getHeaderInfosAndShardInfos(1, 3)
+
+	require.NoError(t, svc.verifyShardingInfo(1, shardInfos[0], headerInfos[0].Header))
+	assert.Equal(t, uint64(1), svc.verifiedSlotInfoDB.InMemoryLatestVerifiedSlot())
+	assert.Equal(t, headerInfos[0].Header.Hash(), svc.verifiedSlotInfoDB.InMemoryLatestVerifiedHeaderHash())
+	assert.Equal(t, uint64(1), svc.verifiedSlotInfoDB.LatestSavedVerifiedSlot())
+	assert.Equal(t, headerInfos[0].Header.Hash(), svc.verifiedSlotInfoDB.LatestVerifiedHeaderHash())
+}
+
+func TestService_VerifyShardingInfo_CrashInjection(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := setup(ctx, t)
+	headerInfos, shardInfos := getHeaderInfosAndShardInfos(1, 3)
+	require.NoError(t, svc.verifyShardingInfo(1, shardInfos[0], headerInfos[0].Header))
+
+	orchestratorDB := svc.verifiedSlotInfoDB
+	svc.verifiedSlotInfoDB = &crashingVerifiedSlotInfoDB{orchestratorDB}
+	slotInfoCh := make(chan *types.SlotInfoWithStatus, 1)
+	sub := svc.SubscribeVerifiedSlotInfoEvent(slotInfoCh)
+	defer sub.Unsubscribe()
+
+	svc.pandoraPendingHeaderCache.Put(ctx, 2, headerInfos[1].Header)
+	svc.vanguardPendingShardingCache.Put(ctx, 2, shardInfos[1])
+	require.ErrorContains(t, errInjectedCrash.Error(), svc.verifyShardingInfo(2, shardInfos[1], headerInfos[1].Header))
+
+	// nothing should be persisted or published for the crashed slot
+	slotInfo, err := orchestratorDB.VerifiedSlotInfo(2)
+	require.NoError(t, err)
+	assert.Equal(t, (*types.SlotInfo)(nil), slotInfo)
+	assert.Equal(t, uint64(1), orchestratorDB.LatestSavedVerifiedSlot())
+	assert.Equal(t, headerInfos[0].Header.Hash(), orchestratorDB.LatestVerifiedHeaderHash())
+	assert.Equal(t, 0, len(slotInfoCh))
+
+	// pending caches are kept so that the slot can be verified again
+	cachedHeader, err := svc.pandoraPendingHeaderCache.Get(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, headerInfos[1].Header.Hash(), cachedHeader.Hash())
+	_, err = svc.vanguardPendingShardingCache.Get(ctx, 2)
+	require.NoError(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/event"
 	"sync"
+	"sync/atomic"
 
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/cache"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
@@ -31,6 +32,7 @@ type Service struct {
 	ctx            context.Context
 	cancel         context.CancelFunc
 	runError       error
+	loopRunning    int32 // 1 while the main event loop is processing
 
 	scope                        event.SubscriptionScope
 	verifiedSlotInfoDB           db.VerifiedSlotInfoDB
@@ -71,6 +73,9 @@ func (s *Service) Start() {
 	s.isRunning = true
 	go func() {
 		log.Info("Starting consensus service")
+		atomic.StoreInt32(&s.loopRunning, 1)
+		defer atomic.StoreInt32(&s.loopRunning, 0)
+
 		vanShardInfoCh := make(chan *types.VanguardShardInfo)
 		panHeaderInfoCh := make(chan *types.PandoraHeaderInfo)
 
@@ -142,6 +147,12 @@ func (s *Service) Status() error {
 	return nil
 }
 
+// IsLoopRunning returns true while the main event loop is processing vanguard shard infos and pandora headers.
+// The loop stops when the service is stopped or when processing fails.
+func (s *Service) IsLoopRunning() bool {
+	return atomic.LoadInt32(&s.loopRunning) == 1
+}
+
 func (s *Service) SubscribeVerifiedSlotInfoEvent(ch chan<- *types.SlotInfoWithStatus) event.Subscription {
 	return s.scope.Track(s.verifiedSlotInfoFeed.Subscribe(ch))
 }
@@ -22,6 +22,22 @@ func TestService_Start(t *testing.T) {
 	hook.Reset()
 }
 
+func TestService_IsLoopRunning(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := setup(ctx, t)
+	assert.Equal(t, false, svc.IsLoopRunning())
+
+	svc.Start()
+	for start := time.Now(); !svc.IsLoopRunning(); time.Sleep(10 * time.Millisecond) {
+		require.Equal(t, true, time.Since(start) < 5*time.Second, "consensus loop did not start")
+	}
+
+	require.NoError(t, svc.Stop())
+	for start := time.Now(); svc.IsLoopRunning(); time.Sleep(10 * time.Millisecond) {
+		require.Equal(t, true, time.Since(start) < 5*time.Second, "consensus loop did not stop")
+	}
+}
+
 func TestService(t *testing.T) {
 	headerInfos, shardInfos := getHeaderInfosAndShardInfos(1, 6)
 	tests := []struct {
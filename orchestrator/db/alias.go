@@ -14,4 +14,6 @@ type VerifiedSlotInfoDB = iface.VerifiedSlotDatabase
 
 type InvalidSlotInfoDB = iface.InvalidSlotDatabase
 
+type ROnlyReorgJournalDB = iface.ReadOnlyReorgJournalDatabase
+
 type Database = iface.Database
@@ -3,12 +3,21 @@ package db
 import (
 	"context"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/db/kv"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/db/memory"
 )
 
 // Assure that Store implements Database interface
 var _ Database = &kv.Store{}
 
+// Assure that in-memory Store implements Database interface
+var _ Database = &memory.Store{}
+
 // NewDB initializes a new DB.
 func NewDB(ctx context.Context, dirPath string, config *kv.Config) (Database, error) {
 	return kv.NewKVStore(ctx, dirPath, config)
 }
+
+// NewMemoryDB initializes a new ephemeral DB which never touches the disk.
+func NewMemoryDB() Database {
+	return memory.NewStore()
+}
@@ -0,0 +1,15 @@
+package db_test
+
+import (
+	"testing"
+
+	testDB "github.com/lukso-network/lukso-orchestrator/orchestrator/db/testing"
+)
+
+func TestBoltStore_Conformance(t *testing.T) {
+	testDB.RunConformanceTests(t, testDB.SetupDB)
+}
+
+func TestMemoryStore_Conformance(t *testing.T) {
+	testDB.RunConformanceTests(t, testDB.SetupMemoryDB)
+}
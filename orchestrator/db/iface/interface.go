@@ -11,8 +11,10 @@ import (
 type ReadOnlyConsensusInfoDatabase interface {
 	ConsensusInfo(ctx context.Context, epoch uint64) (*types.MinimalEpochConsensusInfo, error)
 	ConsensusInfos(fromEpoch uint64) ([]*types.MinimalEpochConsensusInfo, error)
+	ConsensusInfosWithGaps(fromEpoch uint64) ([]*types.MinimalEpochConsensusInfo, []uint64, error)
 	LatestSavedEpoch() uint64
 	GetLatestEpoch() uint64
+	ConsensusInfoRange(fromEpoch, toEpoch uint64, limit int) ([]*types.MinimalEpochConsensusInfo, error)
 }
 
 // ConsensusInfoAccessDatabase
@@ -20,8 +22,9 @@ type ConsensusInfoAccessDatabase interface {
 	ReadOnlyConsensusInfoDatabase
 
 	SaveConsensusInfo(ctx context.Context, consensusInfo *types.MinimalEpochConsensusInfo) error
+	SaveBackfilledConsensusInfo(ctx context.Context, consensusInfo *types.MinimalEpochConsensusInfo) error
 	SaveLatestEpoch(ctx context.Context) error
-	RevertConsensusInfo(reorgInfo *types.MinimalEpochConsensusInfoV2) error
+	RevertConsensusInfo(reorgInfo *types.MinimalEpochConsensusInfoV2) (*types.ReorgRecord, error)
 }
 
 type ReadOnlyVerifiedSlotInfoDatabase interface {
@@ -31,6 +34,9 @@ type ReadOnlyVerifiedSlotInfoDatabase interface {
 	InMemoryLatestVerifiedSlot() uint64
 	LatestVerifiedHeaderHash() common.Hash
 	InMemoryLatestVerifiedHeaderHash() common.Hash
+	VerifiedSlotByPandoraHeaderHash(hash common.Hash) (uint64, bool, error)
+	VerifiedSlotByVanguardBlockHash(hash common.Hash) (uint64, bool, error)
+	VerifiedSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*types.SlotInfoWithSlot, error)
 }
 
 type VerifiedSlotDatabase interface {
@@ -39,10 +45,12 @@ type VerifiedSlotDatabase interface {
 	SaveVerifiedSlotInfo(slot uint64, slotInfo *types.SlotInfo) error
 	SaveLatestVerifiedSlot(ctx context.Context) error
 	SaveLatestVerifiedHeaderHash() error
+	CommitVerifiedSlotInfo(slot uint64, slotInfo *types.SlotInfo) error
 }
 
 type ReadOnlyInvalidSlotInfoDatabase interface {
 	InvalidSlotInfo(slots uint64) (*types.SlotInfo, error)
+	InvalidSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*types.SlotInfoWithSlot, error)
 }
 
 type InvalidSlotDatabase interface {
@@ -51,6 +59,11 @@ type InvalidSlotDatabase interface {
 	SaveInvalidSlotInfo(slot uint64, slotInfo *types.SlotInfo) error
 }
 
+// ReadOnlyReorgJournalDatabase
+type ReadOnlyReorgJournalDatabase interface {
+	ReorgRecords(fromTime, fromSlot uint64) ([]*types.ReorgRecord, error)
+}
+
 // Database interface with full access.
 type Database interface {
 	io.Closer
@@ -61,6 +74,8 @@ type Database interface {
 
 	InvalidSlotDatabase
 
+	ReadOnlyReorgJournalDatabase
+
 	DatabasePath() string
 	ClearDB() error
 }
@@ -9,7 +9,10 @@ import (
 	"github.com/pkg/errors"
 )
 
-var errInvalidEpoch = errors.New("invalid epoch and not found any consensusInfo for the given epoch")
+var (
+	errInvalidEpoch  = errors.New("invalid epoch and not found any consensusInfo for the given epoch")
+	errMissingEpochs = errors.New("consensus infos are missing for some epochs")
+)
 
 // ConsensusInfo
 func (s *Store) ConsensusInfo(ctx context.Context, epoch uint64) (*eventTypes.MinimalEpochConsensusInfo, error) {
@@ -31,17 +34,34 @@ func (s *Store) ConsensusInfo(ctx context.Context, epoch uint64) (*eventTypes.Mi
 	return consensusInfo, err
 }
 
-// ConsensusInfos
+// ConsensusInfos returns consensus infos from the given epoch to the latest saved epoch.
+// It fails when any epoch in the range is missing instead of truncating the result.
 func (s *Store) ConsensusInfos(fromEpoch uint64) (
 	[]*eventTypes.MinimalEpochConsensusInfo, error,
+) {
+	consensusInfos, missingEpochs, err := s.ConsensusInfosWithGaps(fromEpoch)
+	if err != nil {
+		return nil, err
+	}
+	if len(missingEpochs) > 0 {
+		return nil, errors.Wrap(errMissingEpochs, fmt.Sprintf("epochs: %v", missingEpochs))
+	}
+	return consensusInfos, nil
+}
+
+// ConsensusInfosWithGaps returns every stored consensus info from the given epoch to the latest saved epoch
+// together with the epochs which are missing in that range, both in ascending order.
+func (s *Store) ConsensusInfosWithGaps(fromEpoch uint64) (
+	[]*eventTypes.MinimalEpochConsensusInfo, []uint64, error,
 ) {
 	latestEpoch := s.LatestSavedEpoch()
 	// when requested epoch is greater than stored latest epoch
 	if fromEpoch > latestEpoch {
-		return nil, errors.Wrap(errInvalidEpoch, fmt.Sprintf("fromEpoch: %d", fromEpoch))
+		return nil, nil, errors.Wrap(errInvalidEpoch, fmt.Sprintf("fromEpoch: %d", fromEpoch))
 	}
 
 	consensusInfos := make([]*eventTypes.MinimalEpochConsensusInfo, 0)
+	missingEpochs := make([]uint64, 0)
 	err := s.db.View(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket(consensusInfosBucket)
 		for epoch := fromEpoch; epoch <= latestEpoch; epoch++ {
@@ -54,20 +74,23 @@ func (s *Store) ConsensusInfos(fromEpoch uint64) (
 			key := bytesutil.Uint64ToBytesBigEndian(epoch)
 			enc := bkt.Get(key[:])
 			if enc == nil {
-				return nil
+				missingEpochs = append(missingEpochs, epoch)
+				continue
 			}
 			var consensusInfo *eventTypes.MinimalEpochConsensusInfo
-			decode(enc, &consensusInfo)
+			if err := decode(enc, &consensusInfo); err != nil {
+				return err
+			}
 			consensusInfos = append(consensusInfos, consensusInfo)
 		}
 		return nil
 	})
 	// the query not successful
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return consensusInfos, nil
+	return consensusInfos, missingEpochs, nil
 }
 
 // SaveConsensusInfo
@@ -98,6 +121,32 @@ func (s *Store) SaveConsensusInfo(
 	})
 }
 
+// SaveBackfilledConsensusInfo stores consensus info of a missing epoch. Unlike SaveConsensusInfo,
+// it never moves the latest epoch because backfilled epochs are older than the latest one.
+func (s *Store) SaveBackfilledConsensusInfo(
+	ctx context.Context,
+	consensusInfo *eventTypes.MinimalEpochConsensusInfo,
+) error {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(consensusInfosBucket)
+		epochBytes := bytesutil.Uint64ToBytesBigEndian(consensusInfo.Epoch)
+		enc, err := encode(consensusInfo)
+		if err != nil {
+			return err
+		}
+		if err := bkt.Put(epochBytes, enc); err != nil {
+			return err
+		}
+		if status := s.consensusInfoCache.Set(consensusInfo.Epoch, consensusInfo, 0); !status {
+			log.WithField("epoch", consensusInfo.Epoch).Warn("not set in cache")
+		}
+		return nil
+	})
+}
+
 func (s *Store) RemoveRangeConsensusInfo(startEpoch, endEpoch uint64) error {
 	s.Mutex.Lock()
 	defer s.Mutex.Unlock()
@@ -13,7 +13,7 @@ import (
 func TestStore_ConsensusInfo_RetrieveByEpoch_FromCache(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	db := setupDB(t, true)
+	db := setupDB(t)
 	totalConsensusInfos := make([]*eventTypes.MinimalEpochConsensusInfo, 50)
 	for i := 0; i < 50; i++ {
 		consensusInfo := testutil.NewMinimalConsensusInfo(uint64(i))
@@ -30,7 +30,7 @@ func TestStore_ConsensusInfo_RetrieveByEpoch_FromCache(t *testing.T) {
 func TestStore_ConsensusInfo_RetrieveByEpoch_FromDB(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	db := setupDB(t, true)
+	db := setupDB(t)
 	totalConsensusInfos := make([]*eventTypes.MinimalEpochConsensusInfo, 2001)
 	for i := 1; i <= 2000; i++ {
 		consensusInfo := testutil.NewMinimalConsensusInfo(uint64(i))
@@ -47,7 +47,7 @@ func TestStore_ConsensusInfo_RetrieveByEpoch_FromDB(t *testing.T) {
 func TestStore_SaveConsensusInfo_AlreadyExist(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	db := setupDB(t, true)
+	db := setupDB(t)
 
 	consensusInfo := testutil.NewMinimalConsensusInfo(0)
 	epochInfoV2 := consensusInfo.ConvertToEpochInfo()
@@ -59,7 +59,7 @@ func TestStore_SaveConsensusInfo_AlreadyExist(t *testing.T) {
 func TestStore_ConsensusInfos_RetrieveByEpoch(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	db := setupDB(t, true)
+	db := setupDB(t)
 	db.latestEpoch = 199
 	db.SaveLatestEpoch(ctx)
 	totalConsensusInfos := make([]*eventTypes.MinimalEpochConsensusInfo, 200)
@@ -79,7 +79,7 @@ func TestStore_ConsensusInfos_RetrieveByEpoch(t *testing.T) {
 // TestStore_LatestSavedEpoch_ForFirstTime
 func TestStore_LatestSavedEpoch_ForFirstTime(t *testing.T) {
 	t.Parallel()
-	db := setupDB(t, true)
+	db := setupDB(t)
 
 	latestEpoch := db.LatestSavedEpoch()
 	assert.Equal(t, db.latestEpoch, latestEpoch)
@@ -89,7 +89,7 @@ func TestStore_LatestSavedEpoch_ForFirstTime(t *testing.T) {
 func TestStore_SaveLatestSavedEpoch_RetrieveLatestEpoch(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	db := setupDB(t, true)
+	db := setupDB(t)
 	db.latestEpoch = uint64(1000)
 
 	// SaveLatestEpoch is called when db is going to close
@@ -103,7 +103,7 @@ func TestStore_SaveLatestSavedEpoch_RetrieveLatestEpoch(t *testing.T) {
 // TestDB_Close_Success
 func TestDB_Close_Success(t *testing.T) {
 	t.Parallel()
-	db := setupDB(t, true)
+	db := setupDB(t)
 	db.latestEpoch = uint64(1000)
 
 	if db.isRunning {
@@ -113,7 +113,8 @@ func TestDB_Close_Success(t *testing.T) {
 
 func TestStore_LatestEpoch_ClosingDB_OpeningDB(t *testing.T) {
 	t.Parallel()
-	db := setupDB(t, false)
+	dbPath := t.TempDir()
+	db := openDB(t, dbPath)
 	prevLatestEpoch := rand.Uint64()
 	db.latestEpoch = prevLatestEpoch
 
@@ -124,7 +125,7 @@ func TestStore_LatestEpoch_ClosingDB_OpeningDB(t *testing.T) {
 	}
 
 	require.NoError(t, db.Close())
-	restartedDB := setupDB(t, false)
+	restartedDB := openDB(t, dbPath)
 
 	// LatestSavedEpoch is called when db is going up
 	latestEpoch := restartedDB.LatestSavedEpoch()
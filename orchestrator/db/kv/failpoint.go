@@ -0,0 +1,18 @@
+package kv
+
+// commitStage defines a step of CommitVerifiedSlotInfo after which a failure can be injected
+type commitStage int
+
+const (
+	commitStageSlotInfo commitStage = iota
+	commitStageIndices
+	commitStageLatestSlot
+)
+
+// failPoint returns the error injected by tests after the given stage. It is a no-op when no fail point is set.
+func (s *Store) failPoint(stage commitStage) error {
+	if s.commitFailPoint == nil {
+		return nil
+	}
+	return s.commitFailPoint(stage)
+}
@@ -43,6 +43,9 @@ type Store struct {
 	latestVerifiedSlot uint64
 	latestHeaderHash   common.Hash
 	latestVanBlockHash []byte
+
+	// commitFailPoint is only set by tests to simulate a crash in the middle of a commit
+	commitFailPoint func(stage commitStage) error
 	// There should be mutex in store
 	sync.Mutex
 }
@@ -107,10 +110,17 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			consensusInfosBucket,
 			verifiedSlotInfosBucket,
 			invalidSlotInfosBucket,
+			pandoraHeaderHashIndicesBucket,
+			vanguardBlockHashIndicesBucket,
+			reorgJournalBucket,
 		)
 	}); err != nil {
 		return nil, err
 	}
+	// Build hash indices for databases which were created before the index buckets existed
+	if err := kv.db.Update(buildVerifiedSlotIndices); err != nil {
+		return nil, err
+	}
 	// Retrieve initial data from DB
 	kv.initLatestDataFromDB()
 
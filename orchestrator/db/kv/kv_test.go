@@ -8,26 +8,25 @@ import (
 	"testing"
 )
 
-// setupDB instantiates and returns a Store instance.
-func setupDB(t testing.TB, useTempDir bool) *Store {
-	var dbPath string
-	if !useTempDir {
-		dbPath = "./testdata/" + OrchestratorNodeDbDirName
-	} else {
-		dbPath = t.TempDir()
-	}
+// setupDB instantiates and returns a Store instance in a temporary directory.
+func setupDB(t testing.TB) *Store {
+	db := openDB(t, t.TempDir())
+	t.Cleanup(func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	})
+	return db
+}
+
+// openDB instantiates and returns a Store instance at the given path. The caller closes it.
+func openDB(t testing.TB, dbPath string) *Store {
 	db, err := NewKVStore(context.Background(), dbPath, &Config{})
 	require.NoError(t, err, "Failed to instantiate DB")
-	if useTempDir {
-		t.Cleanup(func() {
-			require.NoError(t, db.Close(), "Failed to close database")
-		})
-	}
 	return db
 }
 
 func TestKV_Start_Stop(t *testing.T) {
-	kv := setupDB(t, false)
+	dbPath := t.TempDir()
+	kv := openDB(t, dbPath)
 	defer kv.ClearDB()
 
 	headerHash := common.HexToHash("093eff5a6f078a434dc239817cf9916ab7867152dbf713e9f0f2001b6c1eeb1d")
@@ -36,7 +35,7 @@ func TestKV_Start_Stop(t *testing.T) {
 	kv.latestHeaderHash = headerHash
 
 	require.NoError(t, kv.Close())
-	kv = setupDB(t, false)
+	kv = openDB(t, dbPath)
 	assert.Equal(t, uint64(100), kv.latestVerifiedSlot)
 	assert.Equal(t, uint64(3), kv.latestEpoch)
 	assert.Equal(t, headerHash, kv.latestHeaderHash)
@@ -0,0 +1,88 @@
+package kv
+
+import (
+	"bytes"
+
+	"github.com/boltdb/bolt"
+	"github.com/lukso-network/lukso-orchestrator/shared/bytesutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+// ConsensusInfoRange returns consensus infos from fromEpoch to toEpoch inclusively in ascending order.
+// At most limit consensus infos are returned when limit is positive.
+func (s *Store) ConsensusInfoRange(fromEpoch, toEpoch uint64, limit int) ([]*types.MinimalEpochConsensusInfo, error) {
+	consensusInfos := make([]*types.MinimalEpochConsensusInfo, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return forEachInRange(tx.Bucket(consensusInfosBucket), fromEpoch, toEpoch, limit, func(epoch uint64, val []byte) error {
+			var consensusInfo *types.MinimalEpochConsensusInfo
+			if err := decode(val, &consensusInfo); err != nil {
+				return err
+			}
+			consensusInfos = append(consensusInfos, consensusInfo)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return consensusInfos, nil
+}
+
+// VerifiedSlotInfoRange returns verified slot infos from fromSlot to toSlot inclusively in ascending order.
+// At most limit slot infos are returned when limit is positive.
+func (s *Store) VerifiedSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*types.SlotInfoWithSlot, error) {
+	return s.slotInfoRange(verifiedSlotInfosBucket, fromSlot, toSlot, limit)
+}
+
+// InvalidSlotInfoRange returns invalid slot infos from fromSlot to toSlot inclusively in ascending order.
+// At most limit slot infos are returned when limit is positive.
+func (s *Store) InvalidSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*types.SlotInfoWithSlot, error) {
+	return s.slotInfoRange(invalidSlotInfosBucket, fromSlot, toSlot, limit)
+}
+
+// slotInfoRange
+func (s *Store) slotInfoRange(bucket []byte, fromSlot, toSlot uint64, limit int) ([]*types.SlotInfoWithSlot, error) {
+	slotInfos := make([]*types.SlotInfoWithSlot, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return forEachInRange(tx.Bucket(bucket), fromSlot, toSlot, limit, func(slot uint64, val []byte) error {
+			var slotInfo *types.SlotInfo
+			if err := decode(val, &slotInfo); err != nil {
+				return err
+			}
+			slotInfos = append(slotInfos, &types.SlotInfoWithSlot{Slot: slot, SlotInfo: *slotInfo})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slotInfos, nil
+}
+
+// forEachInRange walks the entries whose big endian uint64 keys are between from and to inclusively in
+// ascending order using a cursor. Other keys, such as latest pointers stored in the same bucket, are skipped.
+// It stops after limit entries when limit is positive.
+func forEachInRange(bkt *bolt.Bucket, from, to uint64, limit int, fn func(key uint64, val []byte) error) error {
+	if from > to {
+		return nil
+	}
+	toKey := bytesutil.Uint64ToBytesBigEndian(to)
+	count := 0
+	cursor := bkt.Cursor()
+	for key, val := cursor.Seek(bytesutil.Uint64ToBytesBigEndian(from)); key != nil; key, val = cursor.Next() {
+		if len(key) != 8 {
+			continue
+		}
+		if bytes.Compare(key, toKey) > 0 {
+			return nil
+		}
+		if err := fn(bytesutil.BytesToUint64BigEndian(key), val); err != nil {
+			return err
+		}
+		count++
+		if limit > 0 && count >= limit {
+			return nil
+		}
+	}
+	return nil
+}
@@ -1,28 +1,124 @@
 package kv
 
 import (
+	"github.com/boltdb/bolt"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/lukso-network/lukso-orchestrator/shared/bytesutil"
 	"github.com/lukso-network/lukso-orchestrator/shared/types"
 )
 
-func (s *Store) RevertConsensusInfo(reorgInfo *types.MinimalEpochConsensusInfoV2) error {
+// RevertConsensusInfo removes verified slot infos and consensus infos which belong to the abandoned branch,
+// rolls back the latest epoch and persists a reorg record into the reorg journal within the same transaction.
+// Consensus infos are removed from the epoch of the reorg consensus info, because vanguard replaces the
+// epochs from there on and does not resend the ones before it.
+func (s *Store) RevertConsensusInfo(reorgInfo *types.MinimalEpochConsensusInfoV2) (*types.ReorgRecord, error) {
+	// the lock is taken before the lookup so that no verified slot info is saved between the lookup and the revert
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
 	// remove from verified database
 	slotInfo := &types.SlotInfo{
 		PandoraHeaderHash: common.BytesToHash(reorgInfo.ReorgInfo.PanParentHash),
 		VanguardBlockHash: common.BytesToHash(reorgInfo.ReorgInfo.VanParentHash),
 	}
 	latestVerifiedSlot := s.LatestSavedVerifiedSlot()
-
 	slotIndex := s.FindVerifiedSlotNumber(slotInfo, latestVerifiedSlot)
-	if slotIndex > 0 {
-		log.WithField("from", slotIndex+1).WithField("skip", reorgInfo.ReorgInfo.NewSlot).Debug("removing verified info")
-		// remove from the dB
-		err := s.RemoveRangeVerifiedInfo(slotIndex+1, reorgInfo.ReorgInfo.NewSlot)
-		if err != nil {
-			log.WithError(err).Error("failed to remove verified information")
+
+	var record *types.ReorgRecord
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		var removedSlots []*types.RemovedSlotInfo
+		if slotIndex > 0 {
+			log.WithField("from", slotIndex+1).WithField("skip", reorgInfo.ReorgInfo.NewSlot).Debug("removing verified info")
+			var err error
+			if removedSlots, err = s.removeRangeVerifiedInfo(tx, slotIndex+1, reorgInfo.ReorgInfo.NewSlot); err != nil {
+				return err
+			}
+		}
+		if err := s.revertConsensusInfos(tx, reorgInfo.Epoch); err != nil {
 			return err
 		}
+		record = types.NewReorgRecord(reorgInfo, slotIndex, removedSlots)
+		return putReorgRecord(tx, record)
+	})
+	if err != nil {
+		log.WithError(err).Error("failed to remove verified information")
+		return nil, err
+	}
+	// latest epoch is rolled back only after the transaction has been committed
+	if latestEpoch := revertedLatestEpoch(reorgInfo.Epoch); s.latestEpoch > latestEpoch {
+		s.latestEpoch = latestEpoch
+	}
+	return record, nil
+}
+
+// revertedLatestEpoch returns the latest epoch which is kept when consensus infos are removed from fromEpoch
+func revertedLatestEpoch(fromEpoch uint64) uint64 {
+	if fromEpoch == 0 {
+		return 0
 	}
+	return fromEpoch - 1
+}
 
+// revertConsensusInfos removes consensus infos from the given epoch and rolls back the saved latest epoch
+func (s *Store) revertConsensusInfos(tx *bolt.Tx, fromEpoch uint64) error {
+	bkt := tx.Bucket(consensusInfosBucket)
+	staleKeys := make([][]byte, 0)
+	cursor := bkt.Cursor()
+	for key, _ := cursor.Seek(bytesutil.Uint64ToBytesBigEndian(fromEpoch)); key != nil; key, _ = cursor.Next() {
+		// latest epoch key is stored in the same bucket
+		if len(key) != 8 {
+			continue
+		}
+		staleKeys = append(staleKeys, key)
+	}
+	for _, key := range staleKeys {
+		s.consensusInfoCache.Del(bytesutil.BytesToUint64BigEndian(key))
+		if err := bkt.Delete(key); err != nil {
+			return err
+		}
+	}
+	if len(staleKeys) > 0 {
+		log.WithField("fromEpoch", fromEpoch).WithField("removed", len(staleKeys)).Debug("removed consensus infos")
+	}
+
+	latestEpoch := revertedLatestEpoch(fromEpoch)
+	savedEpochBytes := bkt.Get(lastStoredEpochKey)
+	if savedEpochBytes != nil && bytesutil.BytesToUint64BigEndian(savedEpochBytes) > latestEpoch {
+		return bkt.Put(lastStoredEpochKey, bytesutil.Uint64ToBytesBigEndian(latestEpoch))
+	}
 	return nil
 }
+
+// ReorgRecords returns journaled reorgs which happened at or after fromTime and affected slots
+// at or after fromSlot. Records are returned in the order they happened.
+func (s *Store) ReorgRecords(fromTime, fromSlot uint64) ([]*types.ReorgRecord, error) {
+	records := make([]*types.ReorgRecord, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(reorgJournalBucket)
+		return bkt.ForEach(func(key, val []byte) error {
+			var record *types.ReorgRecord
+			if err := decode(val, &record); err != nil {
+				return err
+			}
+			if record.Timestamp >= fromTime && record.HighestSlot() >= fromSlot {
+				records = append(records, record)
+			}
+			return nil
+		})
+	})
+	return records, err
+}
+
+// putReorgRecord appends the record to the journal. Keys are monotonic sequence numbers.
+func putReorgRecord(tx *bolt.Tx, record *types.ReorgRecord) error {
+	bkt := tx.Bucket(reorgJournalBucket)
+	seq, err := bkt.NextSequence()
+	if err != nil {
+		return err
+	}
+	enc, err := encode(record)
+	if err != nil {
+		return err
+	}
+	return bkt.Put(bytesutil.Uint64ToBytesBigEndian(seq), enc)
+}
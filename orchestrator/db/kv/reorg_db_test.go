@@ -21,7 +21,14 @@ func TestStore_ReorgDB(t *testing.T) {
 			PanParentHash: []byte{uint8(100)},
 		},
 	}
-	require.NoError(t, db.RevertConsensusInfo(reorgEpochInfo))
+	reorgRecord, err := db.RevertConsensusInfo(reorgEpochInfo)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), reorgRecord.ParentSlot)
+	require.Equal(t, 50, len(reorgRecord.RemovedSlots))
+
+	reorgRecords, err := db.ReorgRecords(0, 0)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*types.ReorgRecord{reorgRecord}, reorgRecords)
 	expectedSlotInfo := (*types.SlotInfo)(nil)
 	for i := 51; i < 100; i++ {
 		actualSlotInfo, err := db.VerifiedSlotInfo(uint64(i))
@@ -41,7 +48,7 @@ func TestStore_ReorgDB(t *testing.T) {
 }
 
 func setupReorgDB(t *testing.T, ctx context.Context) *Store {
-	db := setupDB(t, true)
+	db := setupDB(t)
 	for i := 0; i < 5; i++ {
 		consensusInfo := testutil.NewMinimalConsensusInfo(uint64(i))
 		epochInfoV2 := consensusInfo.ConvertToEpochInfo()
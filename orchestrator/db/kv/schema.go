@@ -6,6 +6,13 @@ var (
 	verifiedSlotInfosBucket = []byte("verified-slots")
 	invalidSlotInfosBucket  = []byte("invalid-slots")
 
+	// secondary index buckets which map block hashes to verified slot numbers
+	pandoraHeaderHashIndicesBucket = []byte("pandora-header-hash-indices")
+	vanguardBlockHashIndicesBucket = []byte("vanguard-block-hash-indices")
+
+	// journal bucket which keeps a record of every reorg
+	reorgJournalBucket = []byte("reorg-journal")
+
 	latestHeaderHashKey        = []byte("latest-header-hash")
 	lastStoredEpochKey         = []byte("last-epoch")
 	latestSavedVerifiedSlotKey = []byte("latest-verified-slot")
@@ -0,0 +1,93 @@
+package kv
+
+import (
+	"bytes"
+
+	"github.com/boltdb/bolt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lukso-network/lukso-orchestrator/shared/bytesutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+// VerifiedSlotByPandoraHeaderHash returns the verified slot number which holds the given pandora header hash.
+// The second return value is false when no verified slot info has the given hash.
+func (s *Store) VerifiedSlotByPandoraHeaderHash(hash common.Hash) (uint64, bool, error) {
+	return s.verifiedSlotByHash(pandoraHeaderHashIndicesBucket, hash)
+}
+
+// VerifiedSlotByVanguardBlockHash returns the verified slot number which holds the given vanguard block hash.
+// The second return value is false when no verified slot info has the given hash.
+func (s *Store) VerifiedSlotByVanguardBlockHash(hash common.Hash) (uint64, bool, error) {
+	return s.verifiedSlotByHash(vanguardBlockHashIndicesBucket, hash)
+}
+
+// verifiedSlotByHash looks up the given hash into the given index bucket
+func (s *Store) verifiedSlotByHash(indexBucket []byte, hash common.Hash) (uint64, bool, error) {
+	var (
+		slot  uint64
+		found bool
+	)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		slotBytes := tx.Bucket(indexBucket).Get(hash.Bytes())
+		if slotBytes == nil {
+			return nil
+		}
+		slot = bytesutil.BytesToUint64BigEndian(slotBytes)
+		found = true
+		return nil
+	})
+	return slot, found, err
+}
+
+// putVerifiedSlotIndices stores pandora header hash and vanguard block hash of the slot info into index buckets.
+// Must be called inside the same transaction which writes the slot info.
+func putVerifiedSlotIndices(tx *bolt.Tx, slotBytes []byte, slotInfo *types.SlotInfo) error {
+	if err := tx.Bucket(pandoraHeaderHashIndicesBucket).Put(slotInfo.PandoraHeaderHash.Bytes(), slotBytes); err != nil {
+		return err
+	}
+	return tx.Bucket(vanguardBlockHashIndicesBucket).Put(slotInfo.VanguardBlockHash.Bytes(), slotBytes)
+}
+
+// deleteVerifiedSlotIndices removes index entries of the slot info. An entry is only removed when it still
+// points to the given slot, so that a later slot with the same hash keeps its index.
+// Must be called inside the same transaction which removes the slot info.
+func deleteVerifiedSlotIndices(tx *bolt.Tx, slotBytes []byte, slotInfo *types.SlotInfo) error {
+	indices := map[string]common.Hash{
+		string(pandoraHeaderHashIndicesBucket): slotInfo.PandoraHeaderHash,
+		string(vanguardBlockHashIndicesBucket): slotInfo.VanguardBlockHash,
+	}
+	for bucketName, hash := range indices {
+		bkt := tx.Bucket([]byte(bucketName))
+		if !bytes.Equal(bkt.Get(hash.Bytes()), slotBytes) {
+			continue
+		}
+		if err := bkt.Delete(hash.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildVerifiedSlotIndices fills up the index buckets from verified slot info bucket when the indices are empty.
+// It helps to migrate a database which has been created before the index buckets were introduced.
+func buildVerifiedSlotIndices(tx *bolt.Tx) error {
+	if key, _ := tx.Bucket(pandoraHeaderHashIndicesBucket).Cursor().First(); key != nil {
+		return nil
+	}
+	bkt := tx.Bucket(verifiedSlotInfosBucket)
+	return bkt.ForEach(func(key, val []byte) error {
+		if isVerifiedSlotMetaKey(key) {
+			return nil
+		}
+		var slotInfo *types.SlotInfo
+		if err := decode(val, &slotInfo); err != nil {
+			return err
+		}
+		return putVerifiedSlotIndices(tx, key, slotInfo)
+	})
+}
+
+// isVerifiedSlotMetaKey returns true when the key of verified slot info bucket does not belong to a slot
+func isVerifiedSlotMetaKey(key []byte) bool {
+	return bytes.Equal(key, latestHeaderHashKey) || bytes.Equal(key, latestSavedVerifiedSlotKey)
+}
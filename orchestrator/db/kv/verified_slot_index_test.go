@@ -0,0 +1,111 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+func TestStore_VerifiedSlotByHash(t *testing.T) {
+	db := setupDB(t)
+	slotInfos := createAndSaveUniqueSlotInfos(t, 64, db)
+
+	for slot, slotInfo := range slotInfos {
+		actualSlot, found, err := db.VerifiedSlotByPandoraHeaderHash(slotInfo.PandoraHeaderHash)
+		require.NoError(t, err)
+		assert.Equal(t, true, found)
+		assert.Equal(t, uint64(slot), actualSlot)
+
+		actualSlot, found, err = db.VerifiedSlotByVanguardBlockHash(slotInfo.VanguardBlockHash)
+		require.NoError(t, err)
+		assert.Equal(t, true, found)
+		assert.Equal(t, uint64(slot), actualSlot)
+	}
+
+	t.Run("should not find unknown hash", func(t *testing.T) {
+		_, found, err := db.VerifiedSlotByPandoraHeaderHash(common.HexToHash("0xff"))
+		require.NoError(t, err)
+		assert.Equal(t, false, found)
+	})
+
+	t.Run("should replace indices when slot info is overwritten", func(t *testing.T) {
+		newSlotInfo := &types.SlotInfo{
+			VanguardBlockHash: common.HexToHash("0x6f701e4e8b260f38a43cdc0d97cfdc7f0cd33f58ef26bbc6c327ac87d76304d2"),
+			PandoraHeaderHash: common.HexToHash("0x0846da512db0a6888a59aa5f7235b741e36a9dcacc9dad33ee2a228878aefa74"),
+		}
+		require.NoError(t, db.SaveVerifiedSlotInfo(10, newSlotInfo))
+
+		_, found, err := db.VerifiedSlotByPandoraHeaderHash(slotInfos[10].PandoraHeaderHash)
+		require.NoError(t, err)
+		assert.Equal(t, false, found)
+
+		actualSlot, found, err := db.VerifiedSlotByVanguardBlockHash(newSlotInfo.VanguardBlockHash)
+		require.NoError(t, err)
+		assert.Equal(t, true, found)
+		assert.Equal(t, uint64(10), actualSlot)
+	})
+}
+
+func TestStore_RemoveRangeVerifiedInfo_RemovesIndices(t *testing.T) {
+	db := setupDB(t)
+	slotInfos := createAndSaveUniqueSlotInfos(t, 64, db)
+	require.NoError(t, db.SaveLatestVerifiedSlot(context.Background()))
+
+	require.NoError(t, db.RemoveRangeVerifiedInfo(32, 40))
+
+	for slot, slotInfo := range slotInfos {
+		_, found, err := db.VerifiedSlotByPandoraHeaderHash(slotInfo.PandoraHeaderHash)
+		require.NoError(t, err)
+		_, vanFound, err := db.VerifiedSlotByVanguardBlockHash(slotInfo.VanguardBlockHash)
+		require.NoError(t, err)
+
+		shouldExist := slot < 32 || slot == 40
+		assert.Equal(t, shouldExist, found, "unexpected pandora index for slot %d", slot)
+		assert.Equal(t, shouldExist, vanFound, "unexpected vanguard index for slot %d", slot)
+	}
+}
+
+func TestStore_BuildVerifiedSlotIndices(t *testing.T) {
+	db := setupDB(t)
+	slotInfos := createAndSaveUniqueSlotInfos(t, 16, db)
+
+	// simulate a database which has been created without index buckets
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{pandoraHeaderHashIndicesBucket, vanguardBlockHashIndicesBucket} {
+			if err := tx.DeleteBucket(bucket); err != nil {
+				return err
+			}
+		}
+		return createBuckets(tx, pandoraHeaderHashIndicesBucket, vanguardBlockHashIndicesBucket)
+	}))
+	_, found, err := db.VerifiedSlotByPandoraHeaderHash(slotInfos[5].PandoraHeaderHash)
+	require.NoError(t, err)
+	require.Equal(t, false, found)
+
+	require.NoError(t, db.db.Update(buildVerifiedSlotIndices))
+	slot, found, err := db.VerifiedSlotByPandoraHeaderHash(slotInfos[5].PandoraHeaderHash)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	assert.Equal(t, uint64(5), slot)
+}
+
+func createAndSaveUniqueSlotInfos(t *testing.T, slotsLen int, db *Store) (slotInfos []*types.SlotInfo) {
+	slotInfos = make([]*types.SlotInfo, slotsLen)
+
+	for i := 0; i < slotsLen; i++ {
+		slotInfo := &types.SlotInfo{
+			VanguardBlockHash: common.BytesToHash([]byte{1, uint8(i)}),
+			PandoraHeaderHash: common.BytesToHash([]byte{2, uint8(i)}),
+		}
+		slotInfos[i] = slotInfo
+
+		require.NoError(t, db.SaveVerifiedSlotInfo(uint64(i), slotInfo))
+	}
+
+	return
+}
@@ -80,16 +80,10 @@ func (s *Store) SaveVerifiedSlotInfo(slot uint64, slotInfo *types.SlotInfo) erro
 
 	// storing consensus info into cache and db
 	return s.db.Update(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(verifiedSlotInfosBucket)
-		slotBytes := bytesutil.Uint64ToBytesBigEndian(slot)
-		enc, err := encode(slotInfo)
-		if err != nil {
-			return err
-		}
 		if status := s.verifiedSlotInfoCache.Set(slot, slotInfo, 0); !status {
 			log.WithField("slot", slot).Warn("could not store verified slot info into cache")
 		}
-		if err := bkt.Put(slotBytes, enc); err != nil {
+		if err := s.putVerifiedSlotInfo(tx, slot, slotInfo); err != nil {
 			return err
 		}
 		// store latest verified slot and latest header hash in in-memory
@@ -100,6 +94,70 @@ func (s *Store) SaveVerifiedSlotInfo(slot uint64, slotInfo *types.SlotInfo) erro
 	})
 }
 
+// CommitVerifiedSlotInfo stores slot info, its hash indices, latest verified slot and latest verified header hash
+// in a single transaction. Either everything is persisted or nothing, so a crash in the middle of a commit never
+// leaves the latest pointers inconsistent with the stored slot infos.
+func (s *Store) CommitVerifiedSlotInfo(slot uint64, slotInfo *types.SlotInfo) error {
+	s.Mutex.Lock()
+	defer s.Mutex.Unlock()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		if err := s.putVerifiedSlotInfo(tx, slot, slotInfo); err != nil {
+			return err
+		}
+		bkt := tx.Bucket(verifiedSlotInfosBucket)
+		slotBytes := bytesutil.Uint64ToBytesBigEndian(slot)
+		if err := bkt.Put(latestSavedVerifiedSlotKey, slotBytes); err != nil {
+			return err
+		}
+		if err := s.failPoint(commitStageLatestSlot); err != nil {
+			return err
+		}
+		return bkt.Put(latestHeaderHashKey, slotInfo.PandoraHeaderHash.Bytes())
+	})
+	if err != nil {
+		return err
+	}
+
+	// in-memory state is only touched when the transaction is committed
+	if status := s.verifiedSlotInfoCache.Set(slot, slotInfo, 0); !status {
+		log.WithField("slot", slot).Warn("could not store verified slot info into cache")
+	}
+	s.latestVerifiedSlot = slot
+	s.latestHeaderHash = slotInfo.PandoraHeaderHash
+	return nil
+}
+
+// putVerifiedSlotInfo stores slot info with its hash indices and removes the indices of the overwritten slot info
+func (s *Store) putVerifiedSlotInfo(tx *bolt.Tx, slot uint64, slotInfo *types.SlotInfo) error {
+	bkt := tx.Bucket(verifiedSlotInfosBucket)
+	slotBytes := bytesutil.Uint64ToBytesBigEndian(slot)
+	enc, err := encode(slotInfo)
+	if err != nil {
+		return err
+	}
+	// remove stale indices when the slot info is overwritten
+	if prevEnc := bkt.Get(slotBytes); prevEnc != nil {
+		var prevSlotInfo *types.SlotInfo
+		if err := decode(prevEnc, &prevSlotInfo); err != nil {
+			return err
+		}
+		if err := deleteVerifiedSlotIndices(tx, slotBytes, prevSlotInfo); err != nil {
+			return err
+		}
+	}
+	if err := bkt.Put(slotBytes, enc); err != nil {
+		return err
+	}
+	if err := s.failPoint(commitStageSlotInfo); err != nil {
+		return err
+	}
+	if err := putVerifiedSlotIndices(tx, slotBytes, slotInfo); err != nil {
+		return err
+	}
+	return s.failPoint(commitStageIndices)
+}
+
 // SaveLatestEpoch
 func (s *Store) SaveLatestVerifiedSlot(ctx context.Context) error {
 	s.Mutex.Lock()
@@ -195,15 +253,21 @@ func (s *Store) InMemoryLatestVerifiedHeaderHash() common.Hash {
 // fromSlot must be higher or equal slot number that is present in db
 // TODO: consider not returning 0 when slot was not found, instead extend this function with multiple return
 func (s *Store) FindVerifiedSlotNumber(info *types.SlotInfo, fromSlot uint64) uint64 {
-	for i := fromSlot; i > 0; i-- {
-		slotInfo, err := s.VerifiedSlotInfo(i)
-		if err != nil {
-			log.WithError(err).Error("failed to find slot info")
-			return 0
-		}
-		if slotInfo != nil && slotInfo.PandoraHeaderHash == info.PandoraHeaderHash && slotInfo.VanguardBlockHash == info.VanguardBlockHash {
-			return i
-		}
+	slot, found, err := s.VerifiedSlotByPandoraHeaderHash(info.PandoraHeaderHash)
+	if err != nil {
+		log.WithError(err).Error("failed to find slot info")
+		return 0
+	}
+	if !found || slot > fromSlot {
+		return 0
+	}
+	slotInfo, err := s.VerifiedSlotInfo(slot)
+	if err != nil {
+		log.WithError(err).Error("failed to find slot info")
+		return 0
+	}
+	if slotInfo != nil && slotInfo.VanguardBlockHash == info.VanguardBlockHash {
+		return slot
 	}
 	return 0
 }
@@ -214,25 +278,45 @@ func (s *Store) RemoveRangeVerifiedInfo(fromSlot, skipSlot uint64) error {
 
 	// storing latest epoch number into db
 	return s.db.Update(func(tx *bolt.Tx) error {
-		bkt := tx.Bucket(verifiedSlotInfosBucket)
-		cursor := bkt.Cursor()
-		for key, val := cursor.Last(); key != nil && val != nil; key, val = cursor.Prev() {
-			if string(key) == string(latestHeaderHashKey) || string(key) == string(latestSavedVerifiedSlotKey) {
-				continue
+		_, err := s.removeRangeVerifiedInfo(tx, fromSlot, skipSlot)
+		return err
+	})
+}
+
+// removeRangeVerifiedInfo walks slots backwards from the highest one and removes every slot except skipSlot
+// until fromSlot is reached. It returns the removed slot infos in descending slot order.
+func (s *Store) removeRangeVerifiedInfo(tx *bolt.Tx, fromSlot, skipSlot uint64) ([]*types.RemovedSlotInfo, error) {
+	removedSlots := make([]*types.RemovedSlotInfo, 0)
+	bkt := tx.Bucket(verifiedSlotInfosBucket)
+	cursor := bkt.Cursor()
+	for key, val := cursor.Last(); key != nil && val != nil; key, val = cursor.Prev() {
+		if isVerifiedSlotMetaKey(key) {
+			continue
+		}
+		slotNumber := bytesutil.BytesToUint64BigEndian(key)
+		if slotNumber != skipSlot {
+			s.verifiedSlotInfoCache.Del(slotNumber)
+
+			var slotInfo *types.SlotInfo
+			if err := decode(val, &slotInfo); err != nil {
+				return nil, err
 			}
-			slotNumber := bytesutil.BytesToUint64BigEndian(key)
-			if slotNumber != skipSlot {
-				s.verifiedSlotInfoCache.Del(slotNumber)
-
-				err := cursor.Delete()
-				if err != nil {
-					return err
-				}
+			if err := deleteVerifiedSlotIndices(tx, key, slotInfo); err != nil {
+				return nil, err
 			}
-			if slotNumber == fromSlot {
-				return nil
+			err := cursor.Delete()
+			if err != nil {
+				return nil, err
 			}
+			removedSlots = append(removedSlots, &types.RemovedSlotInfo{
+				Slot:              slotNumber,
+				VanguardBlockHash: slotInfo.VanguardBlockHash,
+				PandoraHeaderHash: slotInfo.PandoraHeaderHash,
+			})
 		}
-		return nil
-	})
+		if slotNumber == fromSlot {
+			return removedSlots, nil
+		}
+	}
+	return removedSlots, nil
 }
@@ -2,16 +2,18 @@ package kv
 
 import (
 	"context"
+	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	eth1Types "github.com/ethereum/go-ethereum/core/types"
 	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
 	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
 	types "github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
 	"testing"
 )
 
 func TestStore_VerifiedSlotInfo(t *testing.T) {
-	db := setupDB(t, true)
+	db := setupDB(t)
 	slotInfosLen := 32
 	slotInfos := createAndSaveEmptySlotInfos(t, slotInfosLen, db)
 	retrievedSlotInfo, err := db.VerifiedSlotInfo(0)
@@ -20,7 +22,7 @@ func TestStore_VerifiedSlotInfo(t *testing.T) {
 }
 
 func TestStore_VerifiedSlotInfos(t *testing.T) {
-	db := setupDB(t, true)
+	db := setupDB(t)
 	slotInfosLen := 64
 	slotInfos := createAndSaveEmptySlotInfos(t, slotInfosLen, db)
 	require.NoError(t, db.SaveLatestVerifiedSlot(context.Background()))
@@ -31,7 +33,7 @@ func TestStore_VerifiedSlotInfos(t *testing.T) {
 }
 
 func TestStore_LatestVerifiedSuite(t *testing.T) {
-	db := setupDB(t, true)
+	db := setupDB(t)
 	ctx := context.Background()
 	createAndSaveEmptySlotInfos(t, 64, db)
 	customSlotInfoHeight := uint64(64)
@@ -51,7 +53,7 @@ func TestStore_LatestVerifiedSuite(t *testing.T) {
 }
 
 func TestStore_FindVerifiedSlotNumber(t *testing.T) {
-	db := setupDB(t, true)
+	db := setupDB(t)
 	ctx := context.Background()
 	slotInfos := createAndSaveEmptySlotInfos(t, 64, db)
 	customSlotInfoHeight := uint64(64)
@@ -83,7 +85,7 @@ func TestStore_FindVerifiedSlotNumber(t *testing.T) {
 }
 
 func TestStore_RemoveRangeVerifiedInfo(t *testing.T) {
-	db := setupDB(t, true)
+	db := setupDB(t)
 	slotInfosLen := 128
 	slotInfos := createAndSaveEmptySlotInfos(t, slotInfosLen, db)
 	ctx := context.Background()
@@ -126,6 +128,98 @@ func TestStore_RemoveRangeVerifiedInfo(t *testing.T) {
 	})
 }
 
+func TestStore_CommitVerifiedSlotInfo(t *testing.T) {
+	dbPath := t.TempDir()
+	db, err := NewKVStore(context.Background(), dbPath, &Config{})
+	require.NoError(t, err)
+	slotInfos := createAndCommitUniqueSlotInfos(t, 16, db)
+	require.Equal(t, uint64(15), db.InMemoryLatestVerifiedSlot())
+	require.Equal(t, slotInfos[15].PandoraHeaderHash, db.InMemoryLatestVerifiedHeaderHash())
+
+	// simulate a crash, latest pointers are not flushed by Close
+	require.NoError(t, db.db.Close())
+	db, err = NewKVStore(context.Background(), dbPath, &Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	assert.Equal(t, uint64(15), db.LatestSavedVerifiedSlot())
+	assert.Equal(t, slotInfos[15].PandoraHeaderHash, db.LatestVerifiedHeaderHash())
+	for slot, slotInfo := range slotInfos {
+		actualSlotInfo, err := db.VerifiedSlotInfo(uint64(slot))
+		require.NoError(t, err)
+		assert.DeepEqual(t, slotInfo, actualSlotInfo)
+		actualSlot, found, err := db.VerifiedSlotByVanguardBlockHash(slotInfo.VanguardBlockHash)
+		require.NoError(t, err)
+		assert.Equal(t, true, found)
+		assert.Equal(t, uint64(slot), actualSlot)
+	}
+}
+
+func TestStore_CommitVerifiedSlotInfo_CrashInjection(t *testing.T) {
+	stages := []commitStage{commitStageSlotInfo, commitStageIndices, commitStageLatestSlot}
+	errCrash := errors.New("injected crash")
+
+	for _, stage := range stages {
+		t.Run(fmt.Sprintf("crash after stage %d", stage), func(t *testing.T) {
+			dbPath := t.TempDir()
+			db, err := NewKVStore(context.Background(), dbPath, &Config{})
+			require.NoError(t, err)
+			slotInfos := createAndCommitUniqueSlotInfos(t, 10, db)
+
+			failingStage := stage
+			db.commitFailPoint = func(stage commitStage) error {
+				if stage == failingStage {
+					return errCrash
+				}
+				return nil
+			}
+
+			crashedSlotInfo := &types.SlotInfo{
+				VanguardBlockHash: common.HexToHash("0x6f701e4e8b260f38a43cdc0d97cfdc7f0cd33f58ef26bbc6c327ac87d76304d2"),
+				PandoraHeaderHash: common.HexToHash("0x0846da512db0a6888a59aa5f7235b741e36a9dcacc9dad33ee2a228878aefa74"),
+			}
+			require.ErrorContains(t, errCrash.Error(), db.CommitVerifiedSlotInfo(10, crashedSlotInfo))
+			assert.Equal(t, uint64(9), db.InMemoryLatestVerifiedSlot())
+			assert.Equal(t, slotInfos[9].PandoraHeaderHash, db.InMemoryLatestVerifiedHeaderHash())
+
+			// restart without flushing in-memory pointers
+			require.NoError(t, db.db.Close())
+			db, err = NewKVStore(context.Background(), dbPath, &Config{})
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, db.Close())
+			}()
+
+			assert.Equal(t, uint64(9), db.LatestSavedVerifiedSlot())
+			assert.Equal(t, slotInfos[9].PandoraHeaderHash, db.LatestVerifiedHeaderHash())
+			actualSlotInfo, err := db.VerifiedSlotInfo(10)
+			require.NoError(t, err)
+			assert.Equal(t, (*types.SlotInfo)(nil), actualSlotInfo)
+			_, found, err := db.VerifiedSlotByPandoraHeaderHash(crashedSlotInfo.PandoraHeaderHash)
+			require.NoError(t, err)
+			assert.Equal(t, false, found)
+		})
+	}
+}
+
+func createAndCommitUniqueSlotInfos(t *testing.T, slotsLen int, db *Store) (slotInfos []*types.SlotInfo) {
+	slotInfos = make([]*types.SlotInfo, slotsLen)
+
+	for i := 0; i < slotsLen; i++ {
+		slotInfo := &types.SlotInfo{
+			VanguardBlockHash: common.BytesToHash([]byte{1, uint8(i)}),
+			PandoraHeaderHash: common.BytesToHash([]byte{2, uint8(i)}),
+		}
+		slotInfos[i] = slotInfo
+
+		require.NoError(t, db.CommitVerifiedSlotInfo(uint64(i), slotInfo))
+	}
+
+	return
+}
+
 func createAndSaveEmptySlotInfos(t *testing.T, slotsLen int, db *Store) (slotInfos []*types.SlotInfo) {
 	slotInfos = make([]*types.SlotInfo, slotsLen)
 
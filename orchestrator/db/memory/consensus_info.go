@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	errInvalidEpoch  = errors.New("invalid epoch and not found any consensusInfo for the given epoch")
+	errMissingEpochs = errors.New("consensus infos are missing for some epochs")
+)
+
+// ConsensusInfo
+func (s *Store) ConsensusInfo(ctx context.Context, epoch uint64) (*types.MinimalEpochConsensusInfo, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return copyConsensusInfo(s.consensusInfos[epoch]), nil
+}
+
+// ConsensusInfos returns consensus infos from the given epoch to the latest saved epoch.
+// It fails when any epoch in the range is missing instead of truncating the result.
+func (s *Store) ConsensusInfos(fromEpoch uint64) ([]*types.MinimalEpochConsensusInfo, error) {
+	consensusInfos, missingEpochs, err := s.ConsensusInfosWithGaps(fromEpoch)
+	if err != nil {
+		return nil, err
+	}
+	if len(missingEpochs) > 0 {
+		return nil, errors.Wrap(errMissingEpochs, fmt.Sprintf("epochs: %v", missingEpochs))
+	}
+	return consensusInfos, nil
+}
+
+// ConsensusInfosWithGaps returns every stored consensus info from the given epoch to the latest saved epoch
+// together with the epochs which are missing in that range, both in ascending order.
+func (s *Store) ConsensusInfosWithGaps(fromEpoch uint64) ([]*types.MinimalEpochConsensusInfo, []uint64, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if fromEpoch > s.savedLatestEpoch {
+		return nil, nil, errors.Wrap(errInvalidEpoch, fmt.Sprintf("fromEpoch: %d", fromEpoch))
+	}
+	consensusInfos := make([]*types.MinimalEpochConsensusInfo, 0)
+	missingEpochs := make([]uint64, 0)
+	for epoch := fromEpoch; epoch <= s.savedLatestEpoch; epoch++ {
+		consensusInfo, ok := s.consensusInfos[epoch]
+		if !ok {
+			missingEpochs = append(missingEpochs, epoch)
+			continue
+		}
+		consensusInfos = append(consensusInfos, copyConsensusInfo(consensusInfo))
+	}
+	return consensusInfos, missingEpochs, nil
+}
+
+// SaveConsensusInfo
+func (s *Store) SaveConsensusInfo(ctx context.Context, consensusInfo *types.MinimalEpochConsensusInfo) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.consensusInfos[consensusInfo.Epoch] = copyConsensusInfo(consensusInfo)
+	s.latestEpoch = consensusInfo.Epoch
+	return nil
+}
+
+// SaveBackfilledConsensusInfo stores consensus info of a missing epoch without moving the latest epoch
+func (s *Store) SaveBackfilledConsensusInfo(ctx context.Context, consensusInfo *types.MinimalEpochConsensusInfo) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.consensusInfos[consensusInfo.Epoch] = copyConsensusInfo(consensusInfo)
+	return nil
+}
+
+// RemoveRangeConsensusInfo
+func (s *Store) RemoveRangeConsensusInfo(startEpoch, endEpoch uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		delete(s.consensusInfos, epoch)
+	}
+	return nil
+}
+
+// LatestSavedEpoch
+func (s *Store) LatestSavedEpoch() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.savedLatestEpoch
+}
+
+// SaveLatestEpoch
+func (s *Store) SaveLatestEpoch(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.savedLatestEpoch = s.latestEpoch
+	return nil
+}
+
+// GetLatestEpoch
+func (s *Store) GetLatestEpoch() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.latestEpoch
+}
+
+// copyConsensusInfo prevents callers from mutating stored consensus infos
+func copyConsensusInfo(consensusInfo *types.MinimalEpochConsensusInfo) *types.MinimalEpochConsensusInfo {
+	if consensusInfo == nil {
+		return nil
+	}
+	cpy := *consensusInfo
+	if consensusInfo.ValidatorList != nil {
+		cpy.ValidatorList = make([]string, len(consensusInfo.ValidatorList))
+		copy(cpy.ValidatorList, consensusInfo.ValidatorList)
+	}
+	return &cpy
+}
@@ -0,0 +1,22 @@
+package memory
+
+import (
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+// InvalidSlotInfo
+func (s *Store) InvalidSlotInfo(slot uint64) (*types.SlotInfo, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return copySlotInfo(s.invalidSlotInfos[slot]), nil
+}
+
+// SaveInvalidSlotInfo
+func (s *Store) SaveInvalidSlotInfo(slot uint64, slotInfo *types.SlotInfo) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.invalidSlotInfos[slot] = copySlotInfo(slotInfo)
+	return nil
+}
@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+// ConsensusInfoRange returns consensus infos from fromEpoch to toEpoch inclusively in ascending order.
+// At most limit consensus infos are returned when limit is positive.
+func (s *Store) ConsensusInfoRange(fromEpoch, toEpoch uint64, limit int) ([]*types.MinimalEpochConsensusInfo, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	epochs := make([]uint64, 0, len(s.consensusInfos))
+	for epoch := range s.consensusInfos {
+		epochs = append(epochs, epoch)
+	}
+	consensusInfos := make([]*types.MinimalEpochConsensusInfo, 0)
+	for _, epoch := range keysInRange(epochs, fromEpoch, toEpoch, limit) {
+		consensusInfos = append(consensusInfos, copyConsensusInfo(s.consensusInfos[epoch]))
+	}
+	return consensusInfos, nil
+}
+
+// VerifiedSlotInfoRange returns verified slot infos from fromSlot to toSlot inclusively in ascending order.
+// At most limit slot infos are returned when limit is positive.
+func (s *Store) VerifiedSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*types.SlotInfoWithSlot, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return slotInfoRange(s.verifiedSlotInfos, fromSlot, toSlot, limit), nil
+}
+
+// InvalidSlotInfoRange returns invalid slot infos from fromSlot to toSlot inclusively in ascending order.
+// At most limit slot infos are returned when limit is positive.
+func (s *Store) InvalidSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*types.SlotInfoWithSlot, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return slotInfoRange(s.invalidSlotInfos, fromSlot, toSlot, limit), nil
+}
+
+// slotInfoRange collects slot infos of the given map in range. The caller must hold the lock.
+func slotInfoRange(slotInfos map[uint64]*types.SlotInfo, fromSlot, toSlot uint64, limit int) []*types.SlotInfoWithSlot {
+	slots := make([]uint64, 0, len(slotInfos))
+	for slot := range slotInfos {
+		slots = append(slots, slot)
+	}
+	rangeInfos := make([]*types.SlotInfoWithSlot, 0)
+	for _, slot := range keysInRange(slots, fromSlot, toSlot, limit) {
+		rangeInfos = append(rangeInfos, &types.SlotInfoWithSlot{Slot: slot, SlotInfo: *slotInfos[slot]})
+	}
+	return rangeInfos
+}
+
+// keysInRange sorts keys and returns the ones between from and to inclusively, at most limit when limit is positive
+func keysInRange(keys []uint64, from, to uint64, limit int) []uint64 {
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	inRange := make([]uint64, 0)
+	for _, key := range keys {
+		if key < from || key > to {
+			continue
+		}
+		if limit > 0 && len(inRange) >= limit {
+			break
+		}
+		inRange = append(inRange, key)
+	}
+	return inRange
+}
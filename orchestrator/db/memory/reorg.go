@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+// RevertConsensusInfo removes verified slot infos and consensus infos which belong to the abandoned branch,
+// rolls back the latest epoch and appends a reorg record into the reorg journal. Consensus infos are removed
+// from the epoch of the reorg consensus info, because vanguard replaces the epochs from there on.
+func (s *Store) RevertConsensusInfo(reorgInfo *types.MinimalEpochConsensusInfoV2) (*types.ReorgRecord, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	slotInfo := &types.SlotInfo{
+		PandoraHeaderHash: common.BytesToHash(reorgInfo.ReorgInfo.PanParentHash),
+		VanguardBlockHash: common.BytesToHash(reorgInfo.ReorgInfo.VanParentHash),
+	}
+	var removedSlots []*types.RemovedSlotInfo
+	slotIndex := s.findVerifiedSlotNumber(slotInfo, s.savedLatestVerifiedSlot)
+	if slotIndex > 0 {
+		log.WithField("from", slotIndex+1).WithField("skip", reorgInfo.ReorgInfo.NewSlot).Debug("removing verified info")
+		removedSlots = s.removeRangeVerifiedInfo(slotIndex+1, reorgInfo.ReorgInfo.NewSlot)
+	}
+	s.revertConsensusInfos(reorgInfo.Epoch)
+	record := types.NewReorgRecord(reorgInfo, slotIndex, removedSlots)
+	s.reorgRecords = append(s.reorgRecords, copyReorgRecord(record))
+	return record, nil
+}
+
+// revertConsensusInfos removes consensus infos from the given epoch and rolls back the latest epoch.
+// The caller must hold the lock.
+func (s *Store) revertConsensusInfos(fromEpoch uint64) {
+	for consensusEpoch := range s.consensusInfos {
+		if consensusEpoch >= fromEpoch {
+			delete(s.consensusInfos, consensusEpoch)
+		}
+	}
+	latestEpoch := uint64(0)
+	if fromEpoch > 0 {
+		latestEpoch = fromEpoch - 1
+	}
+	if s.latestEpoch > latestEpoch {
+		s.latestEpoch = latestEpoch
+	}
+	if s.savedLatestEpoch > latestEpoch {
+		s.savedLatestEpoch = latestEpoch
+	}
+}
+
+// ReorgRecords returns journaled reorgs which happened at or after fromTime and affected slots
+// at or after fromSlot. Records are returned in the order they happened.
+func (s *Store) ReorgRecords(fromTime, fromSlot uint64) ([]*types.ReorgRecord, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	records := make([]*types.ReorgRecord, 0)
+	for _, record := range s.reorgRecords {
+		if record.Timestamp >= fromTime && record.HighestSlot() >= fromSlot {
+			records = append(records, copyReorgRecord(record))
+		}
+	}
+	return records, nil
+}
+
+// copyReorgRecord prevents callers from mutating journaled records
+func copyReorgRecord(record *types.ReorgRecord) *types.ReorgRecord {
+	cpy := *record
+	if record.ReorgInfo != nil {
+		reorgInfo := *record.ReorgInfo
+		cpy.ReorgInfo = &reorgInfo
+	}
+	cpy.RemovedSlots = make([]*types.RemovedSlotInfo, len(record.RemovedSlots))
+	for i, removedSlot := range record.RemovedSlots {
+		removed := *removedSlot
+		cpy.RemovedSlots[i] = &removed
+	}
+	return &cpy
+}
@@ -0,0 +1,88 @@
+// Package memory provides a map-backed, ephemeral implementation of the orchestrator database.
+// Nothing is written to disk, so it is meant for tests and throwaway devnets only.
+package memory
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+// Store keeps all orchestrator data in memory. It is safe for concurrent use.
+type Store struct {
+	lock sync.RWMutex
+
+	consensusInfos         map[uint64]*types.MinimalEpochConsensusInfo
+	verifiedSlotInfos      map[uint64]*types.SlotInfo
+	invalidSlotInfos       map[uint64]*types.SlotInfo
+	pandoraHeaderHashIndex map[common.Hash]uint64
+	vanguardBlockHashIndex map[common.Hash]uint64
+	reorgRecords           []*types.ReorgRecord
+
+	// Latest information which is kept in memory until it is saved
+	latestEpoch        uint64
+	latestVerifiedSlot uint64
+	latestHeaderHash   common.Hash
+
+	// Latest information which has been saved explicitly
+	savedLatestEpoch        uint64
+	savedLatestVerifiedSlot uint64
+	savedLatestHeaderHash   common.Hash
+}
+
+// NewStore initializes an empty in-memory store.
+func NewStore() *Store {
+	s := &Store{}
+	s.reset()
+	return s
+}
+
+// reset drops every stored data. The caller must hold the lock.
+func (s *Store) reset() {
+	s.consensusInfos = make(map[uint64]*types.MinimalEpochConsensusInfo)
+	s.verifiedSlotInfos = make(map[uint64]*types.SlotInfo)
+	s.invalidSlotInfos = make(map[uint64]*types.SlotInfo)
+	s.pandoraHeaderHashIndex = make(map[common.Hash]uint64)
+	s.vanguardBlockHashIndex = make(map[common.Hash]uint64)
+	s.reorgRecords = make([]*types.ReorgRecord, 0)
+
+	s.latestEpoch, s.savedLatestEpoch = 0, 0
+	s.latestVerifiedSlot, s.savedLatestVerifiedSlot = 0, 0
+	s.latestHeaderHash, s.savedLatestHeaderHash = common.Hash{}, common.Hash{}
+}
+
+// ClearDB removes every stored data from memory.
+func (s *Store) ClearDB() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.reset()
+	return nil
+}
+
+// Close saves the latest in-memory information like the bolt store does on close.
+func (s *Store) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.savedLatestEpoch = s.latestEpoch
+	s.savedLatestVerifiedSlot = s.latestVerifiedSlot
+	s.savedLatestHeaderHash = s.latestHeaderHash
+	log.Info("Closing in-memory db")
+	return nil
+}
+
+// DatabasePath is always empty because nothing is written to disk.
+func (s *Store) DatabasePath() string {
+	return ""
+}
+
+// copySlotInfo prevents callers from mutating stored slot infos
+func copySlotInfo(slotInfo *types.SlotInfo) *types.SlotInfo {
+	if slotInfo == nil {
+		return nil
+	}
+	cpy := *slotInfo
+	return &cpy
+}
@@ -0,0 +1,206 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+var errInvalidSlot = errors.New("invalid slot and not found any verified slot info for the given slot")
+
+// VerifiedSlotInfo
+func (s *Store) VerifiedSlotInfo(slot uint64) (*types.SlotInfo, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return copySlotInfo(s.verifiedSlotInfos[slot]), nil
+}
+
+// VerifiedSlotInfos
+func (s *Store) VerifiedSlotInfos(fromSlot uint64) (map[uint64]*types.SlotInfo, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if fromSlot > s.savedLatestVerifiedSlot {
+		return nil, errors.Wrap(errInvalidSlot, fmt.Sprintf("fromSlot: %d", fromSlot))
+	}
+	slotInfos := make(map[uint64]*types.SlotInfo)
+	for slot, slotInfo := range s.verifiedSlotInfos {
+		if slot >= fromSlot && slot <= s.savedLatestVerifiedSlot {
+			slotInfos[slot] = copySlotInfo(slotInfo)
+		}
+	}
+	return slotInfos, nil
+}
+
+// SaveVerifiedSlotInfo
+func (s *Store) SaveVerifiedSlotInfo(slot uint64, slotInfo *types.SlotInfo) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.putVerifiedSlotInfo(slot, slotInfo)
+	s.latestVerifiedSlot = slot
+	s.latestHeaderHash = slotInfo.PandoraHeaderHash
+	return nil
+}
+
+// CommitVerifiedSlotInfo stores slot info and latest pointers at once
+func (s *Store) CommitVerifiedSlotInfo(slot uint64, slotInfo *types.SlotInfo) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.putVerifiedSlotInfo(slot, slotInfo)
+	s.latestVerifiedSlot, s.savedLatestVerifiedSlot = slot, slot
+	s.latestHeaderHash, s.savedLatestHeaderHash = slotInfo.PandoraHeaderHash, slotInfo.PandoraHeaderHash
+	return nil
+}
+
+// SaveLatestVerifiedSlot
+func (s *Store) SaveLatestVerifiedSlot(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.savedLatestVerifiedSlot = s.latestVerifiedSlot
+	return nil
+}
+
+// LatestSavedVerifiedSlot
+func (s *Store) LatestSavedVerifiedSlot() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.savedLatestVerifiedSlot
+}
+
+// InMemoryLatestVerifiedSlot
+func (s *Store) InMemoryLatestVerifiedSlot() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.latestVerifiedSlot
+}
+
+// SaveLatestVerifiedHeaderHash
+func (s *Store) SaveLatestVerifiedHeaderHash() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.savedLatestHeaderHash = s.latestHeaderHash
+	return nil
+}
+
+// LatestVerifiedHeaderHash
+func (s *Store) LatestVerifiedHeaderHash() common.Hash {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.savedLatestHeaderHash
+}
+
+// InMemoryLatestVerifiedHeaderHash
+func (s *Store) InMemoryLatestVerifiedHeaderHash() common.Hash {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.latestHeaderHash
+}
+
+// VerifiedSlotByPandoraHeaderHash
+func (s *Store) VerifiedSlotByPandoraHeaderHash(hash common.Hash) (uint64, bool, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	slot, found := s.pandoraHeaderHashIndex[hash]
+	return slot, found, nil
+}
+
+// VerifiedSlotByVanguardBlockHash
+func (s *Store) VerifiedSlotByVanguardBlockHash(hash common.Hash) (uint64, bool, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	slot, found := s.vanguardBlockHashIndex[hash]
+	return slot, found, nil
+}
+
+// FindVerifiedSlotNumber
+func (s *Store) FindVerifiedSlotNumber(info *types.SlotInfo, fromSlot uint64) uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.findVerifiedSlotNumber(info, fromSlot)
+}
+
+// RemoveRangeVerifiedInfo
+func (s *Store) RemoveRangeVerifiedInfo(fromSlot, skipSlot uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.removeRangeVerifiedInfo(fromSlot, skipSlot)
+	return nil
+}
+
+// putVerifiedSlotInfo stores slot info with its hash indices. The caller must hold the lock.
+func (s *Store) putVerifiedSlotInfo(slot uint64, slotInfo *types.SlotInfo) {
+	if prevSlotInfo, ok := s.verifiedSlotInfos[slot]; ok {
+		s.deleteVerifiedSlotIndices(slot, prevSlotInfo)
+	}
+	s.verifiedSlotInfos[slot] = copySlotInfo(slotInfo)
+	s.pandoraHeaderHashIndex[slotInfo.PandoraHeaderHash] = slot
+	s.vanguardBlockHashIndex[slotInfo.VanguardBlockHash] = slot
+}
+
+// deleteVerifiedSlotIndices removes index entries which still point to the given slot. The caller must hold the lock.
+func (s *Store) deleteVerifiedSlotIndices(slot uint64, slotInfo *types.SlotInfo) {
+	if indexedSlot, ok := s.pandoraHeaderHashIndex[slotInfo.PandoraHeaderHash]; ok && indexedSlot == slot {
+		delete(s.pandoraHeaderHashIndex, slotInfo.PandoraHeaderHash)
+	}
+	if indexedSlot, ok := s.vanguardBlockHashIndex[slotInfo.VanguardBlockHash]; ok && indexedSlot == slot {
+		delete(s.vanguardBlockHashIndex, slotInfo.VanguardBlockHash)
+	}
+}
+
+// findVerifiedSlotNumber returns 0 when matching slot info is not found. The caller must hold the lock.
+func (s *Store) findVerifiedSlotNumber(info *types.SlotInfo, fromSlot uint64) uint64 {
+	slot, found := s.pandoraHeaderHashIndex[info.PandoraHeaderHash]
+	if !found || slot > fromSlot {
+		return 0
+	}
+	if slotInfo := s.verifiedSlotInfos[slot]; slotInfo != nil && slotInfo.VanguardBlockHash == info.VanguardBlockHash {
+		return slot
+	}
+	return 0
+}
+
+// removeRangeVerifiedInfo walks slots backwards from the highest one and removes every slot except skipSlot
+// until fromSlot is reached, same as the bolt store does. It returns the removed slot infos in descending
+// slot order. The caller must hold the lock.
+func (s *Store) removeRangeVerifiedInfo(fromSlot, skipSlot uint64) []*types.RemovedSlotInfo {
+	removedSlots := make([]*types.RemovedSlotInfo, 0)
+	slots := make([]uint64, 0, len(s.verifiedSlotInfos))
+	for slot := range s.verifiedSlotInfos {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] > slots[j] })
+
+	for _, slot := range slots {
+		if slot != skipSlot {
+			slotInfo := s.verifiedSlotInfos[slot]
+			s.deleteVerifiedSlotIndices(slot, slotInfo)
+			delete(s.verifiedSlotInfos, slot)
+			removedSlots = append(removedSlots, &types.RemovedSlotInfo{
+				Slot:              slot,
+				VanguardBlockHash: slotInfo.VanguardBlockHash,
+				PandoraHeaderHash: slotInfo.PandoraHeaderHash,
+			})
+		}
+		if slot == fromSlot {
+			return removedSlots
+		}
+	}
+	return removedSlots
+}
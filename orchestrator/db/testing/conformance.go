@@ -0,0 +1,370 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
+	"github.com/lukso-network/lukso-orchestrator/shared/params"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+// SetupDBFn creates a fresh database for a single conformance test case.
+type SetupDBFn func(t testing.TB) db.Database
+
+// RunConformanceTests runs the shared behaviour test suite against a database backend.
+// Every backend which implements db.Database must pass it.
+func RunConformanceTests(t *testing.T, setupDB SetupDBFn) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, database db.Database)
+	}{
+		{name: "consensus info", run: testConsensusInfo},
+		{name: "consensus infos range", run: testConsensusInfos},
+		{name: "latest epoch", run: testLatestEpoch},
+		{name: "verified slot info", run: testVerifiedSlotInfo},
+		{name: "latest verified slot", run: testLatestVerifiedSlot},
+		{name: "commit verified slot info", run: testCommitVerifiedSlotInfo},
+		{name: "verified slot hash indices", run: testVerifiedSlotHashIndices},
+		{name: "invalid slot info", run: testInvalidSlotInfo},
+		{name: "revert consensus info", run: testRevertConsensusInfo},
+		{name: "reorg journal", run: testReorgJournal},
+		{name: "revert consensus info epochs", run: testRevertConsensusInfoEpochs},
+		{name: "range queries", run: testRangeQueries},
+		{name: "consensus info gaps", run: testConsensusInfoGaps},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, setupDB(t))
+		})
+	}
+}
+
+func testConsensusInfo(t *testing.T, database db.Database) {
+	ctx := context.Background()
+	consensusInfo := testutil.NewMinimalConsensusInfo(7).ConvertToEpochInfo()
+	require.NoError(t, database.SaveConsensusInfo(ctx, consensusInfo))
+
+	actual, err := database.ConsensusInfo(ctx, 7)
+	require.NoError(t, err)
+	assert.DeepEqual(t, consensusInfo, actual)
+
+	actual, err = database.ConsensusInfo(ctx, 8)
+	require.NoError(t, err)
+	assert.Equal(t, (*types.MinimalEpochConsensusInfo)(nil), actual)
+}
+
+func testConsensusInfos(t *testing.T, database db.Database) {
+	ctx := context.Background()
+	consensusInfos := saveConsensusInfos(t, database, 0, 10)
+	require.NoError(t, database.SaveLatestEpoch(ctx))
+
+	actual, err := database.ConsensusInfos(4)
+	require.NoError(t, err)
+	assert.DeepEqual(t, consensusInfos[4:], actual)
+
+	_, err = database.ConsensusInfos(11)
+	assert.ErrorContains(t, "invalid epoch", err)
+}
+
+func testLatestEpoch(t *testing.T, database db.Database) {
+	ctx := context.Background()
+	saveConsensusInfos(t, database, 0, 5)
+	assert.Equal(t, uint64(5), database.GetLatestEpoch())
+	assert.Equal(t, uint64(0), database.LatestSavedEpoch())
+
+	require.NoError(t, database.SaveLatestEpoch(ctx))
+	assert.Equal(t, uint64(5), database.LatestSavedEpoch())
+}
+
+func testVerifiedSlotInfo(t *testing.T, database db.Database) {
+	ctx := context.Background()
+	slotInfos := saveVerifiedSlotInfos(t, database, 0, 20)
+	require.NoError(t, database.SaveLatestVerifiedSlot(ctx))
+
+	actual, err := database.VerifiedSlotInfo(3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, slotInfos[3], actual)
+
+	actual, err = database.VerifiedSlotInfo(21)
+	require.NoError(t, err)
+	assert.Equal(t, (*types.SlotInfo)(nil), actual)
+
+	actualInfos, err := database.VerifiedSlotInfos(10)
+	require.NoError(t, err)
+	assert.Equal(t, 11, len(actualInfos))
+	assert.DeepEqual(t, slotInfos[15], actualInfos[15])
+
+	_, err = database.VerifiedSlotInfos(21)
+	assert.ErrorContains(t, "invalid slot", err)
+}
+
+func testLatestVerifiedSlot(t *testing.T, database db.Database) {
+	ctx := context.Background()
+	slotInfos := saveVerifiedSlotInfos(t, database, 0, 5)
+	assert.Equal(t, uint64(5), database.InMemoryLatestVerifiedSlot())
+	assert.Equal(t, slotInfos[5].PandoraHeaderHash, database.InMemoryLatestVerifiedHeaderHash())
+	assert.Equal(t, uint64(0), database.LatestSavedVerifiedSlot())
+	assert.Equal(t, common.Hash{}, database.LatestVerifiedHeaderHash())
+
+	require.NoError(t, database.SaveLatestVerifiedSlot(ctx))
+	require.NoError(t, database.SaveLatestVerifiedHeaderHash())
+	assert.Equal(t, uint64(5), database.LatestSavedVerifiedSlot())
+	assert.Equal(t, slotInfos[5].PandoraHeaderHash, database.LatestVerifiedHeaderHash())
+}
+
+func testCommitVerifiedSlotInfo(t *testing.T, database db.Database) {
+	slotInfo := newSlotInfo(12)
+	require.NoError(t, database.CommitVerifiedSlotInfo(12, slotInfo))
+
+	actual, err := database.VerifiedSlotInfo(12)
+	require.NoError(t, err)
+	assert.DeepEqual(t, slotInfo, actual)
+	assert.Equal(t, uint64(12), database.InMemoryLatestVerifiedSlot())
+	assert.Equal(t, uint64(12), database.LatestSavedVerifiedSlot())
+	assert.Equal(t, slotInfo.PandoraHeaderHash, database.InMemoryLatestVerifiedHeaderHash())
+	assert.Equal(t, slotInfo.PandoraHeaderHash, database.LatestVerifiedHeaderHash())
+}
+
+func testVerifiedSlotHashIndices(t *testing.T, database db.Database) {
+	slotInfos := saveVerifiedSlotInfos(t, database, 0, 10)
+
+	slot, found, err := database.VerifiedSlotByPandoraHeaderHash(slotInfos[6].PandoraHeaderHash)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, uint64(6), slot)
+
+	slot, found, err = database.VerifiedSlotByVanguardBlockHash(slotInfos[8].VanguardBlockHash)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	assert.Equal(t, uint64(8), slot)
+
+	// overwriting a slot must drop the stale indices
+	require.NoError(t, database.SaveVerifiedSlotInfo(6, newSlotInfo(100)))
+	_, found, err = database.VerifiedSlotByPandoraHeaderHash(slotInfos[6].PandoraHeaderHash)
+	require.NoError(t, err)
+	assert.Equal(t, false, found)
+}
+
+func testInvalidSlotInfo(t *testing.T, database db.Database) {
+	slotInfo := newSlotInfo(3)
+	require.NoError(t, database.SaveInvalidSlotInfo(3, slotInfo))
+
+	actual, err := database.InvalidSlotInfo(3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, slotInfo, actual)
+
+	actual, err = database.InvalidSlotInfo(4)
+	require.NoError(t, err)
+	assert.Equal(t, (*types.SlotInfo)(nil), actual)
+}
+
+func testRevertConsensusInfo(t *testing.T, database db.Database) {
+	ctx := context.Background()
+	slotInfos := saveVerifiedSlotInfos(t, database, 0, 40)
+	require.NoError(t, database.SaveLatestVerifiedSlot(ctx))
+
+	reorgInfo := &types.MinimalEpochConsensusInfoV2{
+		Epoch: 1,
+		ReorgInfo: &types.Reorg{
+			VanParentHash: slotInfos[30].VanguardBlockHash.Bytes(),
+			PanParentHash: slotInfos[30].PandoraHeaderHash.Bytes(),
+			NewSlot:       35,
+		},
+	}
+	record, err := database.RevertConsensusInfo(reorgInfo)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(30), record.ParentSlot)
+	assert.Equal(t, uint64(31), record.FromSlot)
+	assert.Equal(t, uint64(40), record.ToSlot)
+	assert.Equal(t, 9, len(record.RemovedSlots))
+	assert.DeepEqual(t, &types.RemovedSlotInfo{
+		Slot:              40,
+		VanguardBlockHash: slotInfos[40].VanguardBlockHash,
+		PandoraHeaderHash: slotInfos[40].PandoraHeaderHash,
+	}, record.RemovedSlots[0])
+
+	for slot := uint64(0); slot <= 40; slot++ {
+		actual, err := database.VerifiedSlotInfo(slot)
+		require.NoError(t, err)
+		if slot <= 30 || slot == 35 {
+			assert.DeepEqual(t, slotInfos[slot], actual, "slot %d should be kept", slot)
+			continue
+		}
+		assert.Equal(t, (*types.SlotInfo)(nil), actual, "slot %d should be reverted", slot)
+	}
+}
+
+func testReorgJournal(t *testing.T, database db.Database) {
+	ctx := context.Background()
+	slotInfos := saveVerifiedSlotInfos(t, database, 0, 20)
+	require.NoError(t, database.SaveLatestVerifiedSlot(ctx))
+
+	newReorgInfo := func(epoch, parentSlot, newSlot uint64) *types.MinimalEpochConsensusInfoV2 {
+		return &types.MinimalEpochConsensusInfoV2{
+			Epoch: epoch,
+			ReorgInfo: &types.Reorg{
+				VanParentHash: slotInfos[parentSlot].VanguardBlockHash.Bytes(),
+				PanParentHash: slotInfos[parentSlot].PandoraHeaderHash.Bytes(),
+				NewSlot:       newSlot,
+			},
+		}
+	}
+	first, err := database.RevertConsensusInfo(newReorgInfo(0, 15, 16))
+	require.NoError(t, err)
+	second, err := database.RevertConsensusInfo(newReorgInfo(0, 5, 6))
+	require.NoError(t, err)
+	// parent is not known anymore so nothing is removed but the reorg is still journaled
+	third, err := database.RevertConsensusInfo(newReorgInfo(0, 18, 19))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(third.RemovedSlots))
+
+	records, err := database.ReorgRecords(0, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*types.ReorgRecord{first, second, third}, records)
+
+	records, err = database.ReorgRecords(0, 17)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*types.ReorgRecord{first, third}, records)
+
+	records, err = database.ReorgRecords(third.Timestamp+1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+}
+
+func testRevertConsensusInfoEpochs(t *testing.T, database db.Database) {
+	ctx := context.Background()
+	consensusInfos := saveConsensusInfos(t, database, 0, 10)
+	require.NoError(t, database.SaveLatestEpoch(ctx))
+
+	// reorg spans several epochs. Vanguard resends the epochs from the reorg consensus info's epoch, so the
+	// epochs before it are kept and no gap is left behind
+	reorgInfo := &types.MinimalEpochConsensusInfoV2{
+		Epoch: 6,
+		ReorgInfo: &types.Reorg{
+			VanParentHash: []byte{1},
+			PanParentHash: []byte{2},
+			NewSlot:       3*params.SlotsPerEpoch + 5,
+		},
+	}
+	_, err := database.RevertConsensusInfo(reorgInfo)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), database.GetLatestEpoch())
+	assert.Equal(t, uint64(5), database.LatestSavedEpoch())
+
+	actual, err := database.ConsensusInfos(0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, consensusInfos[:6], actual)
+	for epoch := uint64(6); epoch <= 10; epoch++ {
+		consensusInfo, err := database.ConsensusInfo(ctx, epoch)
+		require.NoError(t, err)
+		assert.Equal(t, (*types.MinimalEpochConsensusInfo)(nil), consensusInfo, "epoch %d should be reverted", epoch)
+	}
+}
+
+func testRangeQueries(t *testing.T, database db.Database) {
+	consensusInfos := saveConsensusInfos(t, database, 0, 10)
+	slotInfos := saveVerifiedSlotInfos(t, database, 0, 20)
+	for slot := uint64(3); slot <= 12; slot += 3 {
+		require.NoError(t, database.SaveInvalidSlotInfo(slot, slotInfos[slot]))
+	}
+	// latest pointers share the buckets with range keys and must not show up in the result
+	require.NoError(t, database.SaveLatestEpoch(context.Background()))
+	require.NoError(t, database.SaveLatestVerifiedSlot(context.Background()))
+	require.NoError(t, database.SaveLatestVerifiedHeaderHash())
+
+	actualConsensusInfos, err := database.ConsensusInfoRange(2, 5, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, consensusInfos[2:6], actualConsensusInfos)
+
+	actualConsensusInfos, err = database.ConsensusInfoRange(8, 100, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, consensusInfos[8:10], actualConsensusInfos)
+
+	actualConsensusInfos, err = database.ConsensusInfoRange(5, 4, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(actualConsensusInfos))
+
+	actualSlotInfos, err := database.VerifiedSlotInfoRange(15, ^uint64(0), 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*types.SlotInfoWithSlot{
+		{Slot: 15, SlotInfo: *slotInfos[15]},
+		{Slot: 16, SlotInfo: *slotInfos[16]},
+		{Slot: 17, SlotInfo: *slotInfos[17]},
+	}, actualSlotInfos)
+
+	actualSlotInfos, err = database.VerifiedSlotInfoRange(18, ^uint64(0), 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(actualSlotInfos))
+
+	actualSlotInfos, err = database.InvalidSlotInfoRange(4, 12, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*types.SlotInfoWithSlot{
+		{Slot: 6, SlotInfo: *slotInfos[6]},
+		{Slot: 9, SlotInfo: *slotInfos[9]},
+		{Slot: 12, SlotInfo: *slotInfos[12]},
+	}, actualSlotInfos)
+}
+
+func testConsensusInfoGaps(t *testing.T, database db.Database) {
+	ctx := context.Background()
+	consensusInfos := make([]*types.MinimalEpochConsensusInfo, 0)
+	for epoch := uint64(0); epoch <= 10; epoch++ {
+		if epoch == 4 || epoch == 7 {
+			continue
+		}
+		consensusInfo := testutil.NewMinimalConsensusInfo(epoch).ConvertToEpochInfo()
+		require.NoError(t, database.SaveConsensusInfo(ctx, consensusInfo))
+		consensusInfos = append(consensusInfos, consensusInfo)
+	}
+	require.NoError(t, database.SaveLatestEpoch(ctx))
+
+	_, err := database.ConsensusInfos(2)
+	assert.ErrorContains(t, "missing", err)
+
+	actual, missingEpochs, err := database.ConsensusInfosWithGaps(2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, consensusInfos[2:], actual)
+	assert.DeepEqual(t, []uint64{4, 7}, missingEpochs)
+
+	// backfilling must not move the latest epoch
+	require.NoError(t, database.SaveBackfilledConsensusInfo(ctx, testutil.NewMinimalConsensusInfo(4).ConvertToEpochInfo()))
+	require.NoError(t, database.SaveBackfilledConsensusInfo(ctx, testutil.NewMinimalConsensusInfo(7).ConvertToEpochInfo()))
+	assert.Equal(t, uint64(10), database.GetLatestEpoch())
+	assert.Equal(t, uint64(10), database.LatestSavedEpoch())
+
+	actual, err = database.ConsensusInfos(2)
+	require.NoError(t, err)
+	assert.Equal(t, 9, len(actual))
+}
+
+// saveConsensusInfos stores consensus infos from fromEpoch to toEpoch inclusively
+func saveConsensusInfos(t *testing.T, database db.Database, fromEpoch, toEpoch uint64) []*types.MinimalEpochConsensusInfo {
+	consensusInfos := make([]*types.MinimalEpochConsensusInfo, toEpoch+1)
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		consensusInfos[epoch] = testutil.NewMinimalConsensusInfo(epoch).ConvertToEpochInfo()
+		require.NoError(t, database.SaveConsensusInfo(context.Background(), consensusInfos[epoch]))
+	}
+	return consensusInfos
+}
+
+// saveVerifiedSlotInfos stores unique verified slot infos from fromSlot to toSlot inclusively
+func saveVerifiedSlotInfos(t *testing.T, database db.Database, fromSlot, toSlot uint64) []*types.SlotInfo {
+	slotInfos := make([]*types.SlotInfo, toSlot+1)
+	for slot := fromSlot; slot <= toSlot; slot++ {
+		slotInfos[slot] = newSlotInfo(slot)
+		require.NoError(t, database.SaveVerifiedSlotInfo(slot, slotInfos[slot]))
+	}
+	return slotInfos
+}
+
+func newSlotInfo(slot uint64) *types.SlotInfo {
+	return &types.SlotInfo{
+		VanguardBlockHash: common.BytesToHash([]byte{1, byte(slot >> 8), byte(slot)}),
+		PandoraHeaderHash: common.BytesToHash([]byte{2, byte(slot >> 8), byte(slot)}),
+	}
+}
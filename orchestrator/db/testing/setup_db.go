@@ -6,6 +6,7 @@ import (
 	"context"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/db/kv"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/db/memory"
 	"testing"
 )
 
@@ -31,3 +32,14 @@ func SetupDBWithoutClose(t testing.TB) db.Database {
 
 	return s
 }
+
+// SetupMemoryDB instantiates and returns database backed by in-memory maps.
+func SetupMemoryDB(t testing.TB) db.Database {
+	s := memory.NewStore()
+	t.Cleanup(func() {
+		if err := s.Close(); err != nil {
+			t.Fatalf("failed to close database: %v", err)
+		}
+	})
+	return s
+}
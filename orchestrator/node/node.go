@@ -5,6 +5,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/math"
 	ethRpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/cache"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/capture"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/consensus"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/db/kv"
@@ -15,6 +16,7 @@ import (
 	"github.com/lukso-network/lukso-orchestrator/shared"
 	"github.com/lukso-network/lukso-orchestrator/shared/cmd"
 	"github.com/lukso-network/lukso-orchestrator/shared/fileutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/jwt"
 	"github.com/lukso-network/lukso-orchestrator/shared/version"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -45,6 +47,10 @@ type OrchestratorNode struct {
 	// lru caches
 	pandoraInfoCache  *cache.PanHeaderCache
 	vanShardInfoCache *cache.VanShardingInfoCache
+
+	// capture support. recorder records the received messages, player replays them instead of the network
+	recorder *capture.Recorder
+	player   *capture.Player
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -67,6 +73,10 @@ func New(cliCtx *cli.Context) (*OrchestratorNode, error) {
 		return nil, err
 	}
 
+	if err := orchestrator.setupCapture(cliCtx); err != nil {
+		return nil, err
+	}
+
 	if err := orchestrator.registerVanguardChainService(cliCtx); err != nil {
 		return nil, err
 	}
@@ -88,6 +98,16 @@ func New(cliCtx *cli.Context) (*OrchestratorNode, error) {
 
 // startDB initialize KV db and cache
 func (o *OrchestratorNode) startDB(cliCtx *cli.Context) error {
+	switch backend := cliCtx.String(cmd.DBBackendFlag.Name); backend {
+	case "", cmd.BoltDBBackend:
+	case cmd.MemoryDBBackend:
+		log.Warn("Using in-memory database. All data will be lost on shutdown")
+		o.db = db.NewMemoryDB()
+		return nil
+	default:
+		return errors.Errorf("unknown db backend %s", backend)
+	}
+
 	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
 	dbPath := filepath.Join(baseDir, kv.OrchestratorNodeDbDirName)
 	clearDB := cliCtx.Bool(cmd.ClearDB.Name)
@@ -133,15 +153,56 @@ func (o *OrchestratorNode) startDB(cliCtx *cli.Context) error {
 	return nil
 }
 
+// setupCapture opens the capture file for recording or loads it for replaying
+func (o *OrchestratorNode) setupCapture(cliCtx *cli.Context) error {
+	captureFile := cliCtx.String(cmd.CaptureFileFlag.Name)
+	replayFile := cliCtx.String(cmd.ReplayFileFlag.Name)
+	if captureFile != "" && replayFile != "" {
+		return errors.Errorf("--%s and --%s cannot be used together", cmd.CaptureFileFlag.Name, cmd.ReplayFileFlag.Name)
+	}
+
+	if captureFile != "" {
+		recorder, err := capture.NewRecorder(captureFile)
+		if err != nil {
+			return err
+		}
+		log.WithField("captureFile", captureFile).Info("Capturing received vanguard and pandora messages")
+		o.recorder = recorder
+	}
+
+	if replayFile != "" {
+		player, err := capture.NewPlayer(replayFile)
+		if err != nil {
+			return err
+		}
+		log.WithField("replayFile", replayFile).
+			WithField("beaconBlocks", player.Len(capture.KindBeaconBlock)).
+			WithField("consensusInfos", player.Len(capture.KindConsensusInfo)).
+			WithField("pandoraHeaders", player.Len(capture.KindPandoraHeader)).
+			Warn("Replaying captured messages instead of connecting to vanguard and pandora nodes")
+		o.player = player
+	}
+	return nil
+}
+
 // registerVanguardChainService
 func (o *OrchestratorNode) registerVanguardChainService(cliCtx *cli.Context) error {
-	vanguardGRPCUrl := cliCtx.String(cmd.VanguardGRPCEndpoint.Name)
+	vanguardGRPCUrls := cliCtx.StringSlice(cmd.VanguardGRPCEndpoint.Name)
+	security := &client.SecurityConfig{
+		CACert:      cliCtx.String(cmd.VanguardGRPCCACertFlag.Name),
+		ClientCert:  cliCtx.String(cmd.VanguardGRPCClientCertFlag.Name),
+		ClientKey:   cliCtx.String(cmd.VanguardGRPCClientKeyFlag.Name),
+		BearerToken: cliCtx.String(cmd.VanguardGRPCTokenFlag.Name),
+	}
 	dialGRPCClient := vanguardchain.DIALGRPCFn(func(endpoint string) (client.VanguardClient, error) {
-		return client.Dial(o.ctx, endpoint, time.Minute*6, 32, math.MaxInt32)
+		return client.Dial(o.ctx, endpoint, time.Minute*6, 32, math.MaxInt32, security)
 	})
+	if o.player != nil {
+		dialGRPCClient = capture.NewVanguardDialer(o.ctx, o.player)
+	}
 	svc, err := vanguardchain.NewService(
 		o.ctx,
-		vanguardGRPCUrl,
+		vanguardGRPCUrls,
 		o.db,
 		o.vanShardInfoCache,
 		dialGRPCClient,
@@ -149,26 +210,47 @@ func (o *OrchestratorNode) registerVanguardChainService(cliCtx *cli.Context) err
 	if err != nil {
 		return nil
 	}
-	log.WithField("vanguardGRPCUrl", vanguardGRPCUrl).Info("Registered vanguard chain service")
+	svc.SetRecorder(o.recorder)
+	log.WithField("vanguardGRPCUrls", vanguardGRPCUrls).Info("Registered vanguard chain service")
 	return o.services.RegisterService(svc)
 }
 
 // registerPandoraChainService
 func (o *OrchestratorNode) registerPandoraChainService(cliCtx *cli.Context) error {
 	pandoraRPCUrl := cliCtx.String(cmd.PandoraRPCEndpoint.Name)
-	dialRPCClient := func(endpoint string) (*ethRpc.Client, error) {
+	dialRPCClient := pandorachain.DialRPCFn(func(endpoint string) (*ethRpc.Client, error) {
 		rpcClient, err := ethRpc.Dial(endpoint)
 		if err != nil {
 			return nil, err
 		}
 		return rpcClient, nil
+	})
+	if secretPath := cliCtx.String(cmd.PandoraJWTSecretFlag.Name); secretPath != "" {
+		secret, err := jwt.LoadSecret(secretPath)
+		if err != nil {
+			return err
+		}
+		dialRPCClient = pandorachain.DialRPCWithJWT(secret)
+	}
+	namespace := cliCtx.String(cmd.PandoraRPCNamespaceFlag.Name)
+	if o.player != nil {
+		replayDialer, err := capture.NewPandoraDialer(o.player, namespace)
+		if err != nil {
+			return err
+		}
+		dialRPCClient = replayDialer
 	}
-	namespace := "eth"
 	svc, err := pandorachain.NewService(o.ctx, pandoraRPCUrl, namespace, o.db, o.pandoraInfoCache, dialRPCClient)
 	if err != nil {
 		return nil
 	}
-	log.WithField("pandoraHttpUrl", pandoraRPCUrl).Info("Registered pandora chain service")
+	svc.SetRecorder(o.recorder)
+	if o.player != nil {
+		svc.SetReplaying()
+	}
+	svc.SetPollInterval(cliCtx.Duration(cmd.PandoraPollIntervalFlag.Name))
+	log.WithField("pandoraHttpUrl", pandoraRPCUrl).WithField("namespace", namespace).
+		Info("Registered pandora chain service")
 	return o.services.RegisterService(svc)
 }
 
@@ -209,6 +291,11 @@ func (o *OrchestratorNode) registerRPCService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	var pandoraChainService *pandorachain.Service
+	if err := o.services.FetchService(&pandoraChainService); err != nil {
+		return err
+	}
+
 	var ipcapiURL string
 	if cliCtx.String(cmd.IPCPathFlag.Name) != "" {
 		ipcFilePath := cliCtx.String(cmd.IPCPathFlag.Name)
@@ -239,10 +326,34 @@ func (o *OrchestratorNode) registerRPCService(cliCtx *cli.Context) error {
 		WSEnable:          wsEnable,
 		WSHost:            wsListenerAddr,
 		WSPort:            wsPort,
+		HTTPCors:          cliCtx.StringSlice(cmd.HTTPCORSDomainFlag.Name),
+		HTTPVirtualHosts:  cliCtx.StringSlice(cmd.HTTPVirtualHostsFlag.Name),
+		HTTPModules:       cliCtx.StringSlice(cmd.HTTPApiFlag.Name),
+		HTTPPathPrefix:    cliCtx.String(cmd.HTTPPathPrefixFlag.Name),
+		HTTPTimeouts: ethRpc.HTTPTimeouts{
+			ReadTimeout:  cliCtx.Duration(cmd.HTTPReadTimeoutFlag.Name),
+			WriteTimeout: cliCtx.Duration(cmd.HTTPWriteTimeoutFlag.Name),
+			IdleTimeout:  cliCtx.Duration(cmd.HTTPIdleTimeoutFlag.Name),
+		},
+		WSModules:    cliCtx.StringSlice(cmd.WSApiFlag.Name),
+		WSOrigins:    cliCtx.StringSlice(cmd.WSAllowedOriginsFlag.Name),
+		WSPathPrefix: cliCtx.String(cmd.WSPathPrefixFlag.Name),
 
 		VanguardPendingShardingCache: o.vanShardInfoCache,
 		PandoraPendingHeaderCache:    o.pandoraInfoCache,
 		VerifiedSlotInfoFeed:         verifiedSlotInfoFeed,
+		ReorgFeed:                    consensusInfoFeed,
+		SyncStatusProvider:           consensusInfoFeed,
+		BackfillProgressProvider:     consensusInfoFeed,
+		ViolationProvider:            consensusInfoFeed,
+		PandoraSyncStatusProvider:    pandoraChainService,
+		RejectedHeaderProvider:       pandoraChainService,
+		ServiceStatusProvider:        o.services,
+		VanguardConnection:           consensusInfoFeed,
+		VanguardEndpoint:             consensusInfoFeed,
+		PandoraConnection:            pandoraChainService,
+		ConsensusLoopProvider:        verifiedSlotInfoFeed,
+		ReadyMaxSlotLag:              cliCtx.Uint64(cmd.HTTPReadyMaxSlotLagFlag.Name),
 	})
 	if err != nil {
 		return nil
@@ -295,6 +406,9 @@ func (b *OrchestratorNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
+	if err := b.recorder.Close(); err != nil {
+		log.Errorf("Failed to close capture file: %v", err)
+	}
 	b.cancel()
 	close(b.stop)
 }
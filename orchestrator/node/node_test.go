@@ -46,3 +46,37 @@ func Test_ClearDB(t *testing.T) {
 	require.LogsContain(t, hook, "Removing database")
 	require.NoError(t, os.RemoveAll(tmp))
 }
+
+// Test_Node_MemoryDBBackend tests that node starts with in-memory database
+func Test_Node_MemoryDBBackend(t *testing.T) {
+	hook := logTest.NewGlobal()
+	tmp := filepath.Join(t.TempDir(), "datadirtest")
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String("datadir", tmp, "node data directory")
+	set.String(cmd.DBBackendFlag.Name, cmd.MemoryDBBackend, "db backend")
+
+	context := cli.NewContext(&app, set, nil)
+	node, err := New(context)
+	require.NoError(t, err)
+	require.LogsContain(t, hook, "Using in-memory database")
+	require.Equal(t, "", node.db.DatabasePath())
+
+	node.Close()
+	require.LogsContain(t, hook, "Stopping orchestrator node")
+}
+
+// Test_Node_UnknownDBBackend tests that node fails to start with unknown database backend
+func Test_Node_UnknownDBBackend(t *testing.T) {
+	tmp := filepath.Join(t.TempDir(), "datadirtest")
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String("datadir", tmp, "node data directory")
+	set.String(cmd.DBBackendFlag.Name, "leveldb", "db backend")
+
+	context := cli.NewContext(&app, set, nil)
+	_, err := New(context)
+	require.ErrorContains(t, "unknown db backend leveldb", err)
+}
@@ -0,0 +1,77 @@
+package pandorachain
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/lukso-network/lukso-orchestrator/shared/jwt"
+	"github.com/pkg/errors"
+)
+
+const (
+	// websocket buffer sizes of go-ethereum's default websocket dialer
+	wsReadBufferSize  = 1024
+	wsWriteBufferSize = 1024
+)
+
+// DialRPCWithJWT returns a dialer which authenticates with pandora by sending a fresh JWT token signed
+// with the shared secret as authorization header. Websocket connections send the token with the handshake
+// of every (re)connection, http connections send it with every request. IPC endpoints are not authenticated.
+func DialRPCWithJWT(secret []byte) DialRPCFn {
+	return func(endpoint string) (*rpc.Client, error) {
+		endpointURL, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse pandora endpoint")
+		}
+		switch endpointURL.Scheme {
+		case "ws", "wss":
+			dialer := websocket.Dialer{
+				ReadBufferSize:  wsReadBufferSize,
+				WriteBufferSize: wsWriteBufferSize,
+				// proxy hook is the only place where the handshake request can be modified. The token is set
+				// here and the connection is made directly
+				Proxy: func(req *http.Request) (*url.URL, error) {
+					return nil, setAuthorizationHeader(req, secret)
+				},
+			}
+			return rpc.DialWebsocketWithDialer(context.Background(), endpoint, "", dialer)
+		case "http", "https":
+			return rpc.DialHTTPWithClient(endpoint, &http.Client{
+				Transport: &jwtTransport{secret: secret, base: http.DefaultTransport},
+			})
+		default:
+			log.WithField("endpoint", endpoint).Warn("JWT secret is ignored for pandora IPC endpoint")
+			return rpc.Dial(endpoint)
+		}
+	}
+}
+
+// jwtTransport sets a fresh token to every http request
+type jwtTransport struct {
+	secret []byte
+	base   http.RoundTripper
+}
+
+// RoundTrip
+func (t *jwtTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// round trippers must not modify the given request
+	req = req.Clone(req.Context())
+	if err := setAuthorizationHeader(req, t.secret); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
+
+// setAuthorizationHeader sets bearer token which is issued now
+func setAuthorizationHeader(req *http.Request, secret []byte) error {
+	token, err := jwt.NewToken(secret, time.Now())
+	if err != nil {
+		return errors.Wrap(err, "could not create jwt token")
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
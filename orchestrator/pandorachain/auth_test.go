@@ -0,0 +1,72 @@
+package pandorachain
+
+import (
+	"testing"
+	"time"
+
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/lukso-network/lukso-orchestrator/shared/jwt"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+// testJWTSecret
+func testJWTSecret(t *testing.T) []byte {
+	secret, err := jwt.ParseSecret("0x7365637265747365637265747365637265747365637265747365637265747365")
+	require.NoError(t, err)
+	return secret
+}
+
+func Test_PandoraSvc_JWTAuth_Websocket(t *testing.T) {
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	secret := testJWTSecret(t)
+	fakeNode.SetJWTSecret(secret)
+	panSvc.dialRPCFn = DialRPCWithJWT(secret)
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 10)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	panSvc.Start()
+	header := fakeNode.MineHeader(1)
+	waitForHeaders(t, headerInfoCh, header)
+
+	// the handshake of the new connection carries a new token
+	fakeNode.Disconnect()
+	nextHeader := fakeNode.MineHeader(2)
+	waitForHeaders(t, headerInfoCh, header, nextHeader)
+	assert.Equal(t, 0, fakeNode.AuthFailures())
+}
+
+func Test_PandoraSvc_JWTAuth_HTTP(t *testing.T) {
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	secret := testJWTSecret(t)
+	fakeNode.SetJWTSecret(secret)
+	panSvc.endpoint = fakeNode.HTTPEndpoint()
+	panSvc.dialRPCFn = DialRPCWithJWT(secret)
+	panSvc.SetPollInterval(10 * time.Millisecond)
+	headers := []*eth1Types.Header{fakeNode.MineHeader(1), fakeNode.MineHeader(2)}
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 10)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	panSvc.Start()
+	waitForHeaders(t, headerInfoCh, headers...)
+	assert.Equal(t, true, panSvc.IsPolling())
+	assert.Equal(t, 0, fakeNode.AuthFailures())
+}
+
+func Test_PandoraSvc_JWTAuth_Rejected(t *testing.T) {
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	fakeNode.SetJWTSecret(testJWTSecret(t))
+	panSvc.dialRPCFn = DialRPCWithJWT(make([]byte, jwt.SecretLength))
+
+	panSvc.Start()
+	waitForCondition(t, func() bool {
+		return panSvc.ConnectionStats().Failures >= 2
+	}, "connection with wrong jwt secret did not fail")
+	assert.Equal(t, true, fakeNode.AuthFailures() >= 2)
+	assert.Equal(t, 0, fakeNode.Subscribers())
+}
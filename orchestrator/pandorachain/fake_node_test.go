@@ -0,0 +1,136 @@
+package pandorachain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/lukso-network/lukso-orchestrator/shared/connection"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+// setupFakeNodeSvc creates pandora service which dials the fake pandora node over websocket
+func setupFakeNodeSvc(t *testing.T) (*Service, *testutil.FakePandoraNode) {
+	oldReconPeriod := reConPeriod
+	reConPeriod = 50 * time.Millisecond
+	t.Cleanup(func() {
+		reConPeriod = oldReconPeriod
+	})
+
+	fakeNode := testutil.NewFakePandoraNode(t)
+	panSvc := SetupPandoraSvc(context.Background(), t, DialRPCClient())
+	panSvc.endpoint = fakeNode.Endpoint()
+	t.Cleanup(func() {
+		_ = panSvc.Stop()
+	})
+	return panSvc, fakeNode
+}
+
+// waitForHeaders waits for the header infos of the given headers in order
+func waitForHeaders(t *testing.T, ch <-chan *types.PandoraHeaderInfo, headers ...*eth1Types.Header) {
+	for _, header := range headers {
+		select {
+		case headerInfo := <-ch:
+			require.Equal(t, header.Hash(), headerInfo.Header.Hash())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for header %s", header.Hash().Hex())
+		}
+	}
+}
+
+// waitForCondition polls the condition until it holds
+func waitForCondition(t *testing.T, condition func() bool, msg string) {
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if condition() {
+			return
+		}
+	}
+	t.Fatal(msg)
+}
+
+func Test_PandoraSvc_FakeNode_SignedHeaders(t *testing.T) {
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	headers := []*eth1Types.Header{fakeNode.MineHeader(1), fakeNode.MineHeader(2), fakeNode.MineHeader(3)}
+	assert.Equal(t, headers[0].Hash(), headers[1].ParentHash)
+	assert.Equal(t, uint64(3), headers[2].Number.Uint64())
+
+	var extraData types.PanExtraDataWithBLSSig
+	require.NoError(t, rlp.DecodeBytes(headers[2].Extra, &extraData))
+	assert.Equal(t, uint64(3), extraData.Slot)
+	assert.Equal(t, uint64(3), extraData.ProposerIndex)
+	// vanguard shard carries the same signature as the header
+	assert.DeepEqual(t, extraData.BlsSignatureBytes.Bytes(), testutil.NewPandoraShard(headers[2]).Signature)
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 10)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	panSvc.Start()
+	waitForHeaders(t, headerInfoCh, headers...)
+
+	liveHeader := fakeNode.MineHeader(4)
+	waitForHeaders(t, headerInfoCh, liveHeader)
+	assert.Equal(t, 1, len(fakeNode.Filters()))
+	assert.Equal(t, connection.Subscribed, panSvc.ConnectionState())
+}
+
+func Test_PandoraSvc_FakeNode_FromBlockHash(t *testing.T) {
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	headers := []*eth1Types.Header{fakeNode.MineHeader(1), fakeNode.MineHeader(2), fakeNode.MineHeader(3)}
+	require.NoError(t, panSvc.db.SaveVerifiedSlotInfo(1, &types.SlotInfo{PandoraHeaderHash: headers[0].Hash()}))
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 10)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	panSvc.Start()
+	// the verified header is not sent again
+	waitForHeaders(t, headerInfoCh, headers[1:]...)
+	filters := fakeNode.Filters()
+	require.Equal(t, 1, len(filters))
+	assert.Equal(t, headers[0].Hash(), filters[0].FromBlockHash)
+}
+
+func Test_PandoraSvc_FakeNode_Faults(t *testing.T) {
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	fakeNode.SetSubscribeError(errors.New("pandora is syncing"))
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 10)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	panSvc.Start()
+	waitForCondition(t, func() bool {
+		return panSvc.ConnectionStats().Failures >= 2
+	}, "subscription error was not retried")
+	assert.Equal(t, 0, fakeNode.Subscribers())
+
+	fakeNode.SetSubscribeError(nil)
+	waitForCondition(t, func() bool {
+		return fakeNode.Subscribers() == 1
+	}, "pandora service did not subscribe after the fault was cleared")
+	header := fakeNode.MineHeader(1)
+	waitForHeaders(t, headerInfoCh, header)
+
+	// dropped connection is restored and the subscription starts over
+	fakeNode.Disconnect()
+	nextHeader := fakeNode.MineHeader(2)
+	waitForHeaders(t, headerInfoCh, header, nextHeader)
+	assert.Equal(t, true, panSvc.ConnectionStats().Successes >= 2)
+
+	// undecodable header is rejected and the subscription stays alive
+	failures := panSvc.ConnectionStats().Failures
+	malformedHeader := fakeNode.SendMalformedHeader(3)
+	lastHeader := fakeNode.MineHeader(3)
+	waitForHeaders(t, headerInfoCh, lastHeader)
+	assert.Equal(t, failures, panSvc.ConnectionStats().Failures)
+	rejectedHeaders := panSvc.RejectedHeaders()
+	require.Equal(t, 1, len(rejectedHeaders))
+	assert.Equal(t, malformedHeader.Hash(), rejectedHeaders[0].HeaderHash)
+}
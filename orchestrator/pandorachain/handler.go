@@ -3,28 +3,52 @@ package pandorachain
 import (
 	"context"
 	eth1Types "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/lukso-network/lukso-orchestrator/shared/types"
 )
 
 // OnNewPendingHeader :
+//	- validate header and record it as rejected when it is invalid
 //	- cache and store header and header hash with status
 //  - send to consensus service for checking header with vanguard header for confirmation
-func (s *Service) OnNewPendingHeader(ctx context.Context, header *eth1Types.Header) error {
-	var panExtraDataWithSig types.PanExtraDataWithBLSSig
-	if err := rlp.DecodeBytes(header.Extra, &panExtraDataWithSig); err != nil {
-		log.WithError(err).Error("Failed to decode extra data fields")
-		return err
+func (s *Service) OnNewPendingHeader(ctx context.Context, header *eth1Types.Header) {
+	// invalid header is skipped so that the subscription stays alive
+	if panExtraDataWithSig, err := s.checkHeader(header); err == nil {
+		s.sendHeaderInfo(header, panExtraDataWithSig)
 	}
+}
+
+// checkHeader validates the header on arrival and records it as rejected when it is invalid
+func (s *Service) checkHeader(header *eth1Types.Header) (*types.PanExtraDataWithBLSSig, error) {
+	panExtraDataWithSig, err := s.verifyHeader(header)
+	if err != nil {
+		s.recordRejectedHeader(header, err)
+		return nil, err
+	}
+	return panExtraDataWithSig, nil
+}
 
+// verifyHeader validates the header against the current slot. Current slot is unknown until the first consensus
+// info arrives or when it can not be read from db. Failing to read db is not a fault of the header, so the future
+// slot check is skipped then.
+func (s *Service) verifyHeader(header *eth1Types.Header) (*types.PanExtraDataWithBLSSig, error) {
+	currentSlot, err := s.currentSlot()
+	if err != nil {
+		log.WithError(err).Warn("Could not derive current slot, skipping future slot check of pandora header")
+	}
+	return validateHeader(header, currentSlot)
+}
+
+// sendHeaderInfo sends the validated header to the consensus service
+func (s *Service) sendHeaderInfo(header *eth1Types.Header, panExtraDataWithSig *types.PanExtraDataWithBLSSig) {
 	log.WithField("slot", panExtraDataWithSig.Slot).
 		WithField("blockNumber", header.Number.Uint64()).
 		WithField("headerHash", header.Hash()).
 		Info("New pandora header info has arrived")
 
+	s.setLastHeaderNumber(header.Number)
+
 	s.pandoraHeaderInfoFeed.Send(&types.PandoraHeaderInfo{
 		Header: header,
 		Slot:   panExtraDataWithSig.Slot,
 	})
-	return nil
 }
@@ -3,7 +3,6 @@ package pandorachain
 import (
 	"context"
 	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
-	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
 	"testing"
 )
 
@@ -15,5 +14,5 @@ func Test_PandoraSvc_OnNewPendingHeader(t *testing.T) {
 
 	panSvc := SetupPandoraSvc(ctx, t, DialInProcClient(inProcServer))
 	newPanHeader := testutil.NewEth1Header(123)
-	require.NoError(t, panSvc.OnNewPendingHeader(ctx, newPanHeader))
+	panSvc.OnNewPendingHeader(ctx, newPanHeader)
 }
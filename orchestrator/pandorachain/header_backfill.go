@@ -0,0 +1,59 @@
+package pandorachain
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+var errPandoraHeaderBackfill = errors.New("Failed to backfill missing pandora headers")
+
+// setLastHeaderNumber
+func (s *Service) setLastHeaderNumber(number *big.Int) {
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+	s.lastHeaderNumber = new(big.Int).Set(number)
+}
+
+// LastHeaderNumber returns the block number of the latest received pandora header. It is nil until the first header.
+func (s *Service) LastHeaderNumber() *big.Int {
+	s.processingLock.RLock()
+	defer s.processingLock.RUnlock()
+	if s.lastHeaderNumber == nil {
+		return nil
+	}
+	return new(big.Int).Set(s.lastHeaderNumber)
+}
+
+// backfillMissingHeaders fetches the headers which pandora skipped between the latest received header and
+// the first header of a new subscription. They are processed in order before the first header.
+func (s *Service) backfillMissingHeaders(ctx context.Context, client *rpc.Client, firstHeader *eth1Types.Header) error {
+	lastNumber := s.LastHeaderNumber()
+	if lastNumber == nil {
+		return nil
+	}
+	fromNumber := new(big.Int).Add(lastNumber, big.NewInt(1))
+	if firstHeader.Number.Cmp(fromNumber) <= 0 {
+		return nil
+	}
+
+	log.WithField("fromNumber", fromNumber).WithField("toNumber", firstHeader.Number).
+		Warn("Found gap in pandora headers after resubscription, backfilling missing headers")
+	for number := fromNumber; number.Cmp(firstHeader.Number) < 0; number = new(big.Int).Add(number, big.NewInt(1)) {
+		header, err := s.headerByNumber(ctx, client, hexutil.EncodeBig(number))
+		if err != nil {
+			return err
+		}
+		if header == nil {
+			return errors.Errorf("pandora does not have header %d", number)
+		}
+		s.recorder.RecordPandoraHeader(header)
+		s.OnNewPendingHeader(ctx, header)
+		log.WithField("blockNumber", number).WithField("headerHash", header.Hash()).Info("Backfilled missing pandora header")
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package pandorachain
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/capture"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+// capturedHeaderNumbers returns the numbers of the captured pandora headers in the order they were recorded
+func capturedHeaderNumbers(t *testing.T, path string) []uint64 {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	numbers := make([]uint64, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := &capture.Record{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), record))
+		header, err := record.PandoraHeader()
+		require.NoError(t, err)
+		numbers = append(numbers, header.Number.Uint64())
+	}
+	require.NoError(t, scanner.Err())
+	return numbers
+}
+
+// restartWithoutHistory drops the subscription and mines the given number of headers while it is down.
+// Like a restarted pandora node, the new subscription does not replay them.
+func restartWithoutHistory(t *testing.T, panSvc *Service, fakeNode *testutil.FakePandoraNode, missed int) []*eth1Types.Header {
+	fakeNode.SetSkipHistory(true)
+	fakeNode.SetSubscribeError(errors.New("pandora is restarting"))
+	fakeNode.Disconnect()
+	waitForCondition(t, func() bool {
+		return panSvc.ConnectionStats().Failures >= 2
+	}, "pandora service did not notice the dropped subscription")
+	missedHeaders := make([]*eth1Types.Header, 0, missed)
+	for i := 0; i < missed; i++ {
+		missedHeaders = append(missedHeaders, fakeNode.MineHeader(uint64(3+i)))
+	}
+
+	fakeNode.SetSubscribeError(nil)
+	waitForCondition(t, func() bool {
+		return fakeNode.Subscribers() == 1
+	}, "pandora service did not resubscribe")
+	return missedHeaders
+}
+
+func Test_PandoraSvc_BackfillMissingHeaders(t *testing.T) {
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	capturePath := filepath.Join(t.TempDir(), "capture.jsonl")
+	recorder, err := capture.NewRecorder(capturePath)
+	require.NoError(t, err)
+	panSvc.SetRecorder(recorder)
+	headers := []*eth1Types.Header{fakeNode.MineHeader(1), fakeNode.MineHeader(2)}
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 10)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	panSvc.Start()
+	waitForHeaders(t, headerInfoCh, headers...)
+	assert.Equal(t, uint64(2), panSvc.LastHeaderNumber().Uint64())
+
+	missedHeaders := restartWithoutHistory(t, panSvc, fakeNode, 2)
+	liveHeader := fakeNode.MineHeader(5)
+
+	waitForHeaders(t, headerInfoCh, missedHeaders[0], missedHeaders[1], liveHeader)
+	assertNoHeader(t, headerInfoCh)
+	assert.Equal(t, uint64(5), panSvc.LastHeaderNumber().Uint64())
+
+	// the capture keeps the processing order, so the backfilled headers come before the live one
+	require.NoError(t, recorder.Close())
+	assert.DeepEqual(t, []uint64{1, 2, 3, 4, 5}, capturedHeaderNumbers(t, capturePath))
+}
+
+// Test_PandoraSvc_BackfillMissingHeaders_Replaying checks that replayed headers are not backfilled
+func Test_PandoraSvc_BackfillMissingHeaders_Replaying(t *testing.T) {
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	panSvc.SetReplaying()
+	headers := []*eth1Types.Header{fakeNode.MineHeader(1), fakeNode.MineHeader(2)}
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 10)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	panSvc.Start()
+	waitForHeaders(t, headerInfoCh, headers...)
+
+	restartWithoutHistory(t, panSvc, fakeNode, 2)
+	liveHeader := fakeNode.MineHeader(5)
+
+	waitForHeaders(t, headerInfoCh, liveHeader)
+	assertNoHeader(t, headerInfoCh)
+	assert.Equal(t, uint64(5), panSvc.LastHeaderNumber().Uint64())
+}
@@ -8,3 +8,11 @@ import (
 type PandoraHeaderFeed interface {
 	SubscribeHeaderInfoEvent(chan<- *types.PandoraHeaderInfo) event.Subscription
 }
+
+type RejectedHeaderProvider interface {
+	RejectedHeaders() []*types.RejectedPandoraHeader
+}
+
+type SyncStatusProvider interface {
+	SyncStatus() *types.PandoraSyncStatus
+}
@@ -0,0 +1,150 @@
+package pandorachain
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultPollInterval is the interval of fetching new headers when pandora does not support subscriptions
+	defaultPollInterval = time.Second
+	// maxDeliveredHeaders is the number of latest delivered header hashes which are kept for deduplication
+	maxDeliveredHeaders = 1024
+)
+
+var errUnknownFromBlockHash = errors.New("pandora does not know the latest verified header")
+
+// deliveredHeaders remembers the hashes of the latest delivered headers in delivery order
+type deliveredHeaders struct {
+	hashes map[common.Hash]struct{}
+	order  []common.Hash
+}
+
+// newDeliveredHeaders
+func newDeliveredHeaders() *deliveredHeaders {
+	return &deliveredHeaders{hashes: make(map[common.Hash]struct{})}
+}
+
+// add returns false when the hash has already been delivered
+func (d *deliveredHeaders) add(hash common.Hash) bool {
+	if _, ok := d.hashes[hash]; ok {
+		return false
+	}
+	d.hashes[hash] = struct{}{}
+	d.order = append(d.order, hash)
+	if len(d.order) > maxDeliveredHeaders {
+		delete(d.hashes, d.order[0])
+		d.order = d.order[1:]
+	}
+	return true
+}
+
+// isSubscriptionUnsupported tells whether the endpoint can not serve subscriptions, like http endpoints
+func isSubscriptionUnsupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, rpc.ErrNotificationsUnsupported) || err.Error() == rpc.ErrNotificationsUnsupported.Error()
+}
+
+// pollPendingHeaders fetches the headers by number from the header after the filter's block hash and
+// keeps polling the latest and pending headers. Every header is delivered once to OnNewPendingHeader.
+func (s *Service) pollPendingHeaders(ctx context.Context, crit *types.PandoraPendingHeaderFilter, client *rpc.Client) error {
+	nextNumber := big.NewInt(1)
+	if crit.FromBlockHash != (common.Hash{}) {
+		var fromHeader *eth1Types.Header
+		if err := client.CallContext(ctx, &fromHeader, s.namespace+"_getBlockByHash", crit.FromBlockHash, false); err != nil {
+			return errors.Wrap(err, "could not fetch the header to poll from")
+		}
+		if fromHeader == nil {
+			return errors.Wrapf(errUnknownFromBlockHash, "hash %s", crit.FromBlockHash.Hex())
+		}
+		nextNumber = new(big.Int).Add(fromHeader.Number, big.NewInt(1))
+	}
+	log.WithField("filterCriteria", crit).WithField("fromNumber", nextNumber).
+		WithField("interval", s.pollInterval).Info("Polling pandora chain for pending block headers")
+
+	go func() {
+		ticker := time.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+		for {
+			var err error
+			if nextNumber, err = s.pollHeaders(ctx, client, nextNumber); err != nil {
+				log.WithError(err).Debug("Got polling error")
+				select {
+				case s.conInfoSubErrCh <- err:
+				case <-ctx.Done():
+				}
+				return
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				log.Info("Received cancelled context, stopping pandora header polling")
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// pollHeaders delivers the headers from the given number up to the latest header and then the pending
+// header. It returns the number of the next header to fetch.
+func (s *Service) pollHeaders(ctx context.Context, client *rpc.Client, nextNumber *big.Int) (*big.Int, error) {
+	latest, err := s.headerByNumber(ctx, client, "latest")
+	if err != nil {
+		return nextNumber, err
+	}
+	if latest != nil {
+		for nextNumber.Cmp(latest.Number) <= 0 {
+			header, err := s.headerByNumber(ctx, client, hexutil.EncodeBig(nextNumber))
+			if err != nil {
+				return nextNumber, err
+			}
+			if header == nil {
+				break
+			}
+			s.deliverPolledHeader(ctx, header)
+			nextNumber = new(big.Int).Add(nextNumber, big.NewInt(1))
+		}
+	}
+
+	pending, err := s.headerByNumber(ctx, client, "pending")
+	if err != nil {
+		return nextNumber, err
+	}
+	// pending header is fetched by number again once it is part of the chain. Deduplication drops it then
+	if pending != nil && pending.Number.Cmp(nextNumber) >= 0 {
+		s.deliverPolledHeader(ctx, pending)
+	}
+	return nextNumber, nil
+}
+
+// headerByNumber returns nil header when pandora does not have the requested header
+func (s *Service) headerByNumber(ctx context.Context, client *rpc.Client, number string) (*eth1Types.Header, error) {
+	var header *eth1Types.Header
+	if err := client.CallContext(ctx, &header, s.namespace+"_getBlockByNumber", number, false); err != nil {
+		return nil, errors.Wrapf(err, "could not fetch %s header", number)
+	}
+	return header, nil
+}
+
+// deliverPolledHeader sends the header to the same handler as the subscription unless it was delivered before
+func (s *Service) deliverPolledHeader(ctx context.Context, header *eth1Types.Header) {
+	s.processingLock.Lock()
+	isNew := s.deliveredHeaders.add(header.Hash())
+	s.processingLock.Unlock()
+	if !isNew {
+		return
+	}
+	s.recorder.RecordPandoraHeader(header)
+	s.OnNewPendingHeader(ctx, header)
+}
@@ -0,0 +1,87 @@
+package pandorachain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+// assertNoHeader checks that no more header is delivered within a few poll intervals
+func assertNoHeader(t *testing.T, ch <-chan *types.PandoraHeaderInfo) {
+	select {
+	case headerInfo := <-ch:
+		t.Fatalf("unexpected header %s", headerInfo.Header.Hash().Hex())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func Test_PandoraSvc_Polling_HTTPEndpoint(t *testing.T) {
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	panSvc.endpoint = fakeNode.HTTPEndpoint()
+	panSvc.SetPollInterval(10 * time.Millisecond)
+	headers := []*eth1Types.Header{fakeNode.MineHeader(1), fakeNode.MineHeader(2)}
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 10)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	panSvc.Start()
+	waitForHeaders(t, headerInfoCh, headers...)
+	assert.Equal(t, true, panSvc.IsPolling())
+
+	// new header is delivered once although latest and pending headers are polled repeatedly
+	nextHeader := fakeNode.MineHeader(3)
+	waitForHeaders(t, headerInfoCh, nextHeader)
+	assertNoHeader(t, headerInfoCh)
+	assert.Equal(t, 0, fakeNode.Subscribers())
+}
+
+func Test_PandoraSvc_Polling_FromBlockHash(t *testing.T) {
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	panSvc.endpoint = fakeNode.HTTPEndpoint()
+	panSvc.SetPollInterval(10 * time.Millisecond)
+	headers := []*eth1Types.Header{fakeNode.MineHeader(1), fakeNode.MineHeader(2), fakeNode.MineHeader(3)}
+	require.NoError(t, panSvc.db.SaveVerifiedSlotInfo(1, &types.SlotInfo{PandoraHeaderHash: headers[0].Hash()}))
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 10)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	panSvc.Start()
+	waitForHeaders(t, headerInfoCh, headers[1:]...)
+	assertNoHeader(t, headerInfoCh)
+}
+
+func Test_PandoraSvc_CustomNamespace(t *testing.T) {
+	panSvc, _ := setupFakeNodeSvc(t)
+	fakeNode := testutil.NewFakePandoraNodeWithNamespace(t, "pan")
+	panSvc.endpoint = fakeNode.Endpoint()
+	panSvc.namespace = "pan"
+	header := fakeNode.MineHeader(1)
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 10)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	panSvc.Start()
+	waitForHeaders(t, headerInfoCh, header)
+	assert.Equal(t, false, panSvc.IsPolling())
+}
+
+func TestDeliveredHeaders_Add(t *testing.T) {
+	delivered := newDeliveredHeaders()
+	for i := 0; i < maxDeliveredHeaders+1; i++ {
+		assert.Equal(t, true, delivered.add(common.BigToHash(big.NewInt(int64(i)))))
+	}
+	assert.Equal(t, false, delivered.add(common.BigToHash(big.NewInt(int64(maxDeliveredHeaders)))))
+	assert.Equal(t, maxDeliveredHeaders, len(delivered.hashes))
+	// the oldest hash is forgotten
+	assert.Equal(t, true, delivered.add(common.BigToHash(big.NewInt(int64(0)))))
+}
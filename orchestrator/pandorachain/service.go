@@ -2,18 +2,22 @@ package pandorachain
 
 import (
 	"context"
+	"math/big"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/event"
 
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/cache"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/capture"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
+	"github.com/lukso-network/lukso-orchestrator/shared/connection"
 	"github.com/lukso-network/lukso-orchestrator/shared/types"
 )
 
-// time to wait before trying to reconnect.
+// initial time to wait before trying to reconnect. It grows exponentially with consecutive failures.
 var reConPeriod = 2 * time.Second
 
 // DialRPCFn dials to the given endpoint
@@ -28,18 +32,30 @@ type Service struct {
 	processingLock sync.RWMutex
 	ctx            context.Context
 	cancel         context.CancelFunc
-	runError       error
 
 	// pandora chain related attributes
-	connected bool
-	endpoint  string
-	rpcClient *rpc.Client
-	dialRPCFn DialRPCFn
-	namespace string
+	connManager *connection.Manager
+	endpoint    string
+	rpcClient   *rpc.Client
+	dialRPCFn   DialRPCFn
+	namespace   string
 
 	// subscription
 	conInfoSubErrCh chan error
 	conInfoSub      *rpc.ClientSubscription
+	// polling is used instead of subscription when pandora endpoint does not support subscriptions
+	polling          bool
+	pollInterval     time.Duration
+	deliveredHeaders *deliveredHeaders
+	// lastHeaderNumber is the number of the latest received header. Used to detect gaps after resubscription
+	lastHeaderNumber *big.Int
+	// syncStatus is refreshed by polling pandora's sync progress and head block number
+	syncStatus *types.PandoraSyncStatus
+	// hash and number of the latest verified header. Used to avoid fetching the same header repeatedly
+	verifiedHeaderHash   common.Hash
+	verifiedHeaderNumber uint64
+	// rejectedHeaders failed validation on arrival and have not been processed
+	rejectedHeaders []*types.RejectedPandoraHeader
 
 	// db support
 	db    db.Database
@@ -47,6 +63,11 @@ type Service struct {
 
 	scope                 event.SubscriptionScope
 	pandoraHeaderInfoFeed event.Feed
+
+	// recorder captures received headers. Nil when capturing is disabled
+	recorder *capture.Recorder
+	// replaying is set when the headers are replayed from a capture instead of a pandora node
+	replaying bool
 }
 
 // NewService creates new service with pandora ws or ipc endpoint, pandora service namespace and db
@@ -62,14 +83,17 @@ func NewService(
 	ctx, cancel := context.WithCancel(ctx)
 	_ = cancel // govet fix for lost cancel. Cancel is handled in service.Stop()
 	return &Service{
-		ctx:             ctx,
-		cancel:          cancel,
-		endpoint:        endpoint,
-		dialRPCFn:       dialRPCFn,
-		namespace:       namespace,
-		conInfoSubErrCh: make(chan error),
-		db:              db,
-		cache:           cache,
+		ctx:              ctx,
+		cancel:           cancel,
+		connManager:      connection.NewManager("pandora", connection.DefaultBackoff(reConPeriod)),
+		endpoint:         endpoint,
+		dialRPCFn:        dialRPCFn,
+		namespace:        namespace,
+		conInfoSubErrCh:  make(chan error),
+		pollInterval:     defaultPollInterval,
+		deliveredHeaders: newDeliveredHeaders(),
+		db:               db,
+		cache:            cache,
 	}, nil
 }
 
@@ -86,6 +110,7 @@ func (s *Service) Start() {
 			log.Info("Context closed, exiting pandora goroutine")
 			return
 		}
+		go s.pollSyncStatus()
 		s.run(s.ctx.Done())
 	}()
 }
@@ -96,6 +121,7 @@ func (s *Service) Stop() error {
 	}
 	s.closeClients()
 	s.scope.Close()
+	s.connManager.Disconnect()
 	return nil
 }
 
@@ -104,11 +130,50 @@ func (s *Service) Status() error {
 	if !s.isRunning {
 		return nil
 	}
-	// get error from run function
-	if s.runError != nil {
-		return s.runError
+	if err := s.connManager.Status(); err != nil {
+		return err
 	}
-	return nil
+	return s.syncStatusErr()
+}
+
+// SetRecorder captures every received pandora header from now on
+func (s *Service) SetRecorder(recorder *capture.Recorder) {
+	s.recorder = recorder
+}
+
+// SetReplaying marks that the headers are replayed from a capture. The replay server only serves the header
+// subscription, so missing headers are not backfilled.
+func (s *Service) SetReplaying() {
+	s.replaying = true
+}
+
+// SetPollInterval sets the interval of fetching new headers when pandora does not support subscriptions
+func (s *Service) SetPollInterval(interval time.Duration) {
+	if interval > 0 {
+		s.pollInterval = interval
+	}
+}
+
+// IsPolling tells whether the headers are polled because pandora endpoint does not support subscriptions
+func (s *Service) IsPolling() bool {
+	s.processingLock.RLock()
+	defer s.processingLock.RUnlock()
+	return s.polling
+}
+
+// ConnectionState returns the state of the pandora connection
+func (s *Service) ConnectionState() connection.State {
+	return s.connManager.State()
+}
+
+// ConnectionStats returns the counters of the pandora connection
+func (s *Service) ConnectionStats() connection.Stats {
+	return s.connManager.Stats()
+}
+
+// SubscribeConnectionStateEvent subscribes to the state transitions of the pandora connection
+func (s *Service) SubscribeConnectionStateEvent(ch chan<- *connection.StateEvent) event.Subscription {
+	return s.scope.Track(s.connManager.SubscribeStateEvent(ch))
 }
 
 // closes down our active eth1 clients.
@@ -119,45 +184,27 @@ func (s *Service) closeClients() {
 }
 
 // waitForConnection waits for a connection with pandora chain. Until a successful connection and subscription with
-// pandora chain, it retries again and again.
+// pandora chain, it retries with exponential backoff.
 func (s *Service) waitForConnection() {
 	log.Debug("Waiting for the connection")
-	var err error
-	if err = s.connectToChain(); err == nil {
-		log.WithField("endpoint", s.endpoint).Info("Connected and subscribed to pandora chain")
-		s.connected = true
-		return
-	}
-	log.WithError(err).Warn("Could not connect or subscribe to pandora chain")
-	s.runError = err
-	ticker := time.NewTicker(reConPeriod)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			log.WithField("endpoint", s.endpoint).Debug("Dialing pandora node")
-			var errConnect error
-			if errConnect = s.connectToChain(); errConnect != nil {
-				log.WithError(errConnect).Warn("Could not connect or subscribe to pandora chain")
-				s.runError = errConnect
-				continue
-			}
-			s.connected = true
-			s.runError = nil
-			log.WithField("endpoint", s.endpoint).Info("Connected and subscribed to pandora chain")
-			return
-		case <-s.ctx.Done():
-			log.Info("Received cancelled context, closing existing pandora client connection service")
-			return
+	err := s.connManager.Connect(s.ctx, func() error {
+		log.WithField("endpoint", s.endpoint).Debug("Dialing pandora node")
+		if err := s.connectToChain(); err != nil {
+			log.WithError(err).Warn("Could not connect or subscribe to pandora chain")
+			return err
 		}
+		return nil
+	})
+	if err != nil {
+		log.Info("Received cancelled context, closing existing pandora client connection service")
+		return
 	}
+	log.WithField("endpoint", s.endpoint).Info("Connected and subscribed to pandora chain")
 }
 
 // run subscribes to all the services for the ETH1.0 chain.
 func (s *Service) run(done <-chan struct{}) {
 	log.Debug("Pandora chain service is starting")
-	s.runError = nil
 
 	// the loop waits for any error which comes from consensus info subscription
 	// if any subscription error happens, it will try to reconnect and re-subscribe with pandora chain again.
@@ -165,7 +212,6 @@ func (s *Service) run(done <-chan struct{}) {
 		select {
 		case <-done:
 			s.isRunning = false
-			s.runError = nil
 			log.Info("Context closed, exiting pandora chain service goroutine")
 			return
 		case err := <-s.conInfoSubErrCh:
@@ -197,13 +243,9 @@ func (s *Service) connectToChain() error {
 
 // retryToConnectAndSubscribe retries to pandora chain in case of any failure.
 func (s *Service) retryToConnectAndSubscribe(err error) {
-	s.runError = err
-	s.connected = false
-	// Back off for a while before resuming dialing the pandora node.
-	time.Sleep(reConPeriod)
+	// waitForConnection backs off for a while before resuming dialing the pandora node.
+	s.connManager.Fail(err)
 	s.waitForConnection()
-	// Reset run error in the event of a successful connection.
-	s.runError = nil
 }
 
 // subscribe subscribes to pandora events
@@ -214,14 +256,27 @@ func (s *Service) subscribe() error {
 	}
 	// subscribe to pandora client for pending headers
 	sub, err := s.SubscribePendingHeaders(s.ctx, filter, s.namespace, s.rpcClient)
+	if isSubscriptionUnsupported(err) {
+		log.WithField("endpoint", s.endpoint).Warn("Pandora endpoint does not support subscriptions, falling back to polling")
+		s.setPolling(true)
+		return s.pollPendingHeaders(s.ctx, filter, s.rpcClient)
+	}
 	if err != nil {
 		log.WithError(err).Warn("Could not subscribe to pandora client for new pending headers")
 		return err
 	}
+	s.setPolling(false)
 	s.conInfoSub = sub
 	return nil
 }
 
+// setPolling
+func (s *Service) setPolling(polling bool) {
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+	s.polling = polling
+}
+
 func (s *Service) SubscribeHeaderInfoEvent(ch chan<- *types.PandoraHeaderInfo) event.Subscription {
 	return s.scope.Track(s.pandoraHeaderInfoFeed.Subscribe(ch))
 }
@@ -5,11 +5,6 @@ import (
 	eth1Types "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/lukso-network/lukso-orchestrator/shared/types"
-	"github.com/pkg/errors"
-)
-
-var (
-	errPandoraHeaderProcessing = errors.New("Failed to process the pending pandora header")
 )
 
 // subscribePendingHeaders subscribes to pandora client from latest saved slot using given rpc client
@@ -28,20 +23,43 @@ func (s *Service) SubscribePendingHeaders(
 
 	// Start up a dispatcher to feed into the callback
 	go func() {
+		firstHeader := true
 		for {
 			select {
 			case newPendingHeader := <-ch:
-				// dispatch newPendingHeader to handler
-				err = s.OnNewPendingHeader(ctx, newPendingHeader)
-				if nil != err {
-					log.WithError(err).Error("Failed to process the pending pandora header")
-					s.conInfoSubErrCh <- errPandoraHeaderProcessing
-					return
+				if !firstHeader || s.replaying {
+					s.recorder.RecordPandoraHeader(newPendingHeader)
+					// dispatch newPendingHeader to handler
+					s.OnNewPendingHeader(ctx, newPendingHeader)
+					continue
+				}
+				// pandora may not replay every header which was missed while the subscription was down.
+				// Invalid header is rejected and can not be used as the backfill boundary.
+				// A replayed capture already contains the backfilled headers
+				panExtraDataWithSig, rejectReason := s.checkHeader(newPendingHeader)
+				if rejectReason == nil {
+					firstHeader = false
+					if err := s.backfillMissingHeaders(ctx, client, newPendingHeader); err != nil {
+						log.WithError(err).Error("Failed to backfill missing pandora headers")
+						select {
+						case s.conInfoSubErrCh <- errPandoraHeaderBackfill:
+						case <-ctx.Done():
+						}
+						return
+					}
+				}
+				// backfilled headers are recorded first, so that the capture keeps the processing order
+				s.recorder.RecordPandoraHeader(newPendingHeader)
+				if rejectReason == nil {
+					s.sendHeaderInfo(newPendingHeader, panExtraDataWithSig)
 				}
 			case err := <-sub.Err():
 				if err != nil {
 					log.WithError(err).Debug("Got subscription error")
-					s.conInfoSubErrCh <- err
+					select {
+					case s.conInfoSubErrCh <- err:
+					case <-ctx.Done():
+					}
 				}
 				return
 			case <-ctx.Done():
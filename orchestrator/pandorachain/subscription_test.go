@@ -3,11 +3,14 @@ package pandorachain
 import (
 	"context"
 	"github.com/ethereum/go-ethereum/common"
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
 	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
 	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
 	"github.com/lukso-network/lukso-orchestrator/shared/types"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -33,3 +36,31 @@ func Test_PandoraSvc_PendingHeaderSub(t *testing.T) {
 	time.Sleep(1 * time.Second)
 	assert.LogsContain(t, hook, "New pandora header info has arrived")
 }
+
+// consensusInfoReadCounter counts consensus info reads, one for each validated header
+type consensusInfoReadCounter struct {
+	db.Database
+	reads int64
+}
+
+func (d *consensusInfoReadCounter) ConsensusInfo(ctx context.Context, epoch uint64) (*types.MinimalEpochConsensusInfo, error) {
+	atomic.AddInt64(&d.reads, 1)
+	return d.Database.ConsensusInfo(ctx, epoch)
+}
+
+// Test_PandoraSvc_PendingHeaderSub_ValidatesOnce checks that the first header is not validated again after backfill
+func Test_PandoraSvc_PendingHeaderSub_ValidatesOnce(t *testing.T) {
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	readCounter := &consensusInfoReadCounter{Database: panSvc.db}
+	panSvc.db = readCounter
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 10)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	panSvc.Start()
+	headers := []*eth1Types.Header{fakeNode.MineHeader(1), fakeNode.MineHeader(2), fakeNode.MineHeader(3)}
+	waitForHeaders(t, headerInfoCh, headers...)
+	assertNoHeader(t, headerInfoCh)
+	assert.Equal(t, int64(len(headers)), atomic.LoadInt64(&readCounter.reads))
+}
@@ -0,0 +1,161 @@
+package pandorachain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/lukso-network/lukso-orchestrator/shared/params"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	// syncStatusPollPeriod is the interval to poll pandora's sync progress and head block number
+	syncStatusPollPeriod = 12 * time.Second
+	// maxSyncDistance is the number of blocks which the latest verified block may lag behind pandora's head
+	maxSyncDistance = uint64(params.SlotsPerEpoch)
+)
+
+var (
+	errPandoraSyncing = errors.New("pandora node is syncing")
+	errPandoraLagging = errors.New("orchestrator is lagging behind pandora chain")
+)
+
+// syncProgress is the result of eth_syncing while pandora is syncing
+type syncProgress struct {
+	CurrentBlock hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock hexutil.Uint64 `json:"highestBlock"`
+}
+
+// pollSyncStatus updates sync status periodically until the service stops
+func (s *Service) pollSyncStatus() {
+	if err := s.updateSyncStatus(s.ctx); err != nil {
+		log.WithError(err).Debug("Could not update pandora sync status")
+	}
+	ticker := time.NewTicker(syncStatusPollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.updateSyncStatus(s.ctx); err != nil {
+				log.WithError(err).Debug("Could not update pandora sync status")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// updateSyncStatus compares pandora's head block number with the latest received and verified block numbers
+func (s *Service) updateSyncStatus(ctx context.Context) error {
+	if s.rpcClient == nil {
+		return errors.New("pandora client is not connected")
+	}
+
+	var syncing json.RawMessage
+	if err := s.rpcClient.CallContext(ctx, &syncing, s.namespace+"_syncing"); err != nil {
+		return errors.Wrap(err, "could not fetch sync progress")
+	}
+	var headNumber hexutil.Uint64
+	if err := s.rpcClient.CallContext(ctx, &headNumber, s.namespace+"_blockNumber"); err != nil {
+		return errors.Wrap(err, "could not fetch head block number")
+	}
+	// eth_syncing returns false when pandora is not syncing
+	var highestNumber uint64
+	if string(syncing) != "false" {
+		var progress syncProgress
+		if err := json.Unmarshal(syncing, &progress); err != nil {
+			return errors.Wrap(err, "could not decode sync progress")
+		}
+		highestNumber = uint64(progress.HighestBlock)
+	}
+
+	verifiedNumber, err := s.latestVerifiedHeaderNumber(ctx)
+	if err != nil {
+		return err
+	}
+	var receivedNumber uint64
+	if lastHeaderNumber := s.LastHeaderNumber(); lastHeaderNumber != nil {
+		receivedNumber = lastHeaderNumber.Uint64()
+	}
+
+	syncStatus := types.NewPandoraSyncStatus(
+		uint64(headNumber),
+		highestNumber,
+		receivedNumber,
+		verifiedNumber,
+		maxSyncDistance,
+	)
+	log.WithField("state", syncStatus.State).
+		WithField("headBlockNumber", syncStatus.HeadBlockNumber).
+		WithField("receivedDistance", syncStatus.ReceivedDistance).
+		WithField("verifiedDistance", syncStatus.VerifiedDistance).
+		Trace("Updated pandora sync status")
+
+	s.processingLock.Lock()
+	s.syncStatus = syncStatus
+	s.processingLock.Unlock()
+	return nil
+}
+
+// latestVerifiedHeaderNumber returns the block number of the latest verified pandora header. The header is
+// fetched from pandora only when the latest verified header has changed.
+func (s *Service) latestVerifiedHeaderNumber(ctx context.Context) (uint64, error) {
+	verifiedHash := s.db.InMemoryLatestVerifiedHeaderHash()
+	if verifiedHash == (common.Hash{}) {
+		return 0, nil
+	}
+	s.processingLock.RLock()
+	cachedHash, cachedNumber := s.verifiedHeaderHash, s.verifiedHeaderNumber
+	s.processingLock.RUnlock()
+	if verifiedHash == cachedHash {
+		return cachedNumber, nil
+	}
+
+	var header *eth1Types.Header
+	if err := s.rpcClient.CallContext(ctx, &header, s.namespace+"_getBlockByHash", verifiedHash, false); err != nil {
+		return 0, errors.Wrap(err, "could not fetch latest verified header")
+	}
+	if header == nil {
+		return 0, errors.Errorf("pandora does not have the latest verified header %s", verifiedHash.Hex())
+	}
+
+	s.processingLock.Lock()
+	s.verifiedHeaderHash, s.verifiedHeaderNumber = verifiedHash, header.Number.Uint64()
+	s.processingLock.Unlock()
+	return header.Number.Uint64(), nil
+}
+
+// SyncStatus returns the latest sync status. It is nil until pandora's head has been fetched once
+func (s *Service) SyncStatus() *types.PandoraSyncStatus {
+	s.processingLock.RLock()
+	defer s.processingLock.RUnlock()
+
+	if s.syncStatus == nil {
+		return nil
+	}
+	syncStatus := *s.syncStatus
+	return &syncStatus
+}
+
+// syncStatusErr returns error while pandora is syncing or orchestrator lags too far behind pandora's head
+func (s *Service) syncStatusErr() error {
+	syncStatus := s.SyncStatus()
+	if syncStatus == nil {
+		return nil
+	}
+	switch syncStatus.State {
+	case types.PandoraSyncing:
+		return errors.Wrapf(errPandoraSyncing, "at block %d of %d",
+			syncStatus.HeadBlockNumber, syncStatus.HighestBlockNumber)
+	case types.PandoraLagging:
+		return errors.Wrapf(errPandoraLagging, "%d blocks behind head block %d",
+			syncStatus.VerifiedDistance, syncStatus.HeadBlockNumber)
+	}
+	return nil
+}
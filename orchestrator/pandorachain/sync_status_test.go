@@ -0,0 +1,60 @@
+package pandorachain
+
+import (
+	"context"
+	"testing"
+
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+func TestService_UpdateSyncStatus(t *testing.T) {
+	ctx := context.Background()
+	panSvc, fakeNode := setupFakeNodeSvc(t)
+	assert.Equal(t, true, panSvc.SyncStatus() == nil)
+	assert.NoError(t, panSvc.syncStatusErr())
+	assert.ErrorContains(t, "pandora client is not connected", panSvc.updateSyncStatus(ctx))
+
+	headers := make([]*eth1Types.Header, 0)
+	for slot := uint64(1); slot <= maxSyncDistance+8; slot++ {
+		headers = append(headers, fakeNode.MineHeader(slot))
+	}
+	headNumber := headers[len(headers)-1].Number.Uint64()
+	client, err := panSvc.dialRPCFn(fakeNode.Endpoint())
+	require.NoError(t, err)
+	panSvc.rpcClient = client
+
+	// nothing has been verified yet
+	require.NoError(t, panSvc.updateSyncStatus(ctx))
+	syncStatus := panSvc.SyncStatus()
+	require.NotNil(t, syncStatus)
+	assert.Equal(t, types.PandoraLagging, syncStatus.State)
+	assert.Equal(t, headNumber, syncStatus.HeadBlockNumber)
+	assert.Equal(t, headNumber, syncStatus.ReceivedDistance)
+	assert.Equal(t, headNumber, syncStatus.VerifiedDistance)
+	assert.ErrorContains(t, errPandoraLagging.Error(), panSvc.syncStatusErr())
+
+	// verified header is close to the head
+	verifiedHeader := headers[len(headers)-2]
+	require.NoError(t, panSvc.db.SaveVerifiedSlotInfo(verifiedHeader.Number.Uint64(), &types.SlotInfo{
+		PandoraHeaderHash: verifiedHeader.Hash(),
+	}))
+	panSvc.setLastHeaderNumber(verifiedHeader.Number)
+	require.NoError(t, panSvc.updateSyncStatus(ctx))
+	syncStatus = panSvc.SyncStatus()
+	assert.Equal(t, types.PandoraSynced, syncStatus.State)
+	assert.Equal(t, verifiedHeader.Number.Uint64(), syncStatus.LatestVerifiedBlockNumber)
+	assert.Equal(t, uint64(1), syncStatus.ReceivedDistance)
+	assert.Equal(t, uint64(1), syncStatus.VerifiedDistance)
+	assert.NoError(t, panSvc.syncStatusErr())
+
+	// pandora is syncing from its peers
+	fakeNode.SetSyncing(headNumber + 100)
+	require.NoError(t, panSvc.updateSyncStatus(ctx))
+	syncStatus = panSvc.SyncStatus()
+	assert.Equal(t, types.PandoraSyncing, syncStatus.State)
+	assert.Equal(t, headNumber+100, syncStatus.HighestBlockNumber)
+	assert.ErrorContains(t, errPandoraSyncing.Error(), panSvc.syncStatusErr())
+}
@@ -0,0 +1,119 @@
+package pandorachain
+
+import (
+	"time"
+
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/lukso-network/lukso-orchestrator/shared/params"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxFutureSlots is the number of slots a header may be ahead of the current slot
+	maxFutureSlots = 2
+	// maxRejectedHeaders is the number of latest rejected headers which are kept for inspection
+	maxRejectedHeaders = 100
+)
+
+var (
+	errInvalidExtraData      = errors.New("header extra data could not be decoded")
+	errInvalidBlockNumber    = errors.New("header block number is missing or zero")
+	errInvalidSignatureLen   = errors.New("header signature has invalid length")
+	errEmptySignature        = errors.New("header signature is empty")
+	errFutureSlot            = errors.New("header slot is too far in the future")
+	errConsensusInfoNotFound = errors.New("could not fetch latest consensus info")
+)
+
+// rawPanExtraData is decoded first to check the signature length before decoding to PanExtraDataWithBLSSig
+type rawPanExtraData struct {
+	types.ExtraData
+	Signature []byte
+}
+
+// validateHeader checks the pandora header before it is processed. The returned error is the reason to reject it.
+//  - extra data decodes to slot, epoch, proposer index and signature
+//  - block number is non-zero
+//  - signature has BLS signature length and is not empty
+//  - slot is not more than maxFutureSlots ahead of the current slot, when the current slot is known
+func validateHeader(header *eth1Types.Header, currentSlot *uint64) (*types.PanExtraDataWithBLSSig, error) {
+	var rawExtraData rawPanExtraData
+	if err := rlp.DecodeBytes(header.Extra, &rawExtraData); err != nil {
+		return nil, errors.Wrap(errInvalidExtraData, err.Error())
+	}
+	if header.Number == nil || header.Number.Sign() <= 0 {
+		return nil, errInvalidBlockNumber
+	}
+	if len(rawExtraData.Signature) != types.BLSSignatureSize {
+		return nil, errors.Wrapf(errInvalidSignatureLen, "got %d bytes, want %d",
+			len(rawExtraData.Signature), types.BLSSignatureSize)
+	}
+	extraData := &types.PanExtraDataWithBLSSig{
+		ExtraData:         rawExtraData.ExtraData,
+		BlsSignatureBytes: types.BytesToSig(rawExtraData.Signature),
+	}
+	if extraData.BlsSignatureBytes == (types.BlsSignatureBytes{}) {
+		return nil, errEmptySignature
+	}
+
+	if currentSlot != nil && extraData.Slot > *currentSlot+maxFutureSlots {
+		return nil, errors.Wrapf(errFutureSlot, "slot %d, current slot %d", extraData.Slot, *currentSlot)
+	}
+	return extraData, nil
+}
+
+// currentSlot derives the wall clock slot from the latest stored consensus info
+func (s *Service) currentSlot() (*uint64, error) {
+	latestEpoch := s.db.LatestSavedEpoch()
+	consensusInfo, err := s.db.ConsensusInfo(s.ctx, latestEpoch)
+	if err != nil {
+		return nil, errors.Wrap(errConsensusInfoNotFound, err.Error())
+	}
+	if consensusInfo == nil {
+		return nil, nil
+	}
+	slot := consensusInfo.Epoch * params.SlotsPerEpoch
+	// slot time duration holds the number of seconds per slot
+	now := uint64(time.Now().Unix())
+	if slotDuration := uint64(consensusInfo.SlotTimeDuration); slotDuration > 0 && now > consensusInfo.EpochStartTime {
+		slot += (now - consensusInfo.EpochStartTime) / slotDuration
+	}
+	return &slot, nil
+}
+
+// recordRejectedHeader keeps the rejected header for inspection
+func (s *Service) recordRejectedHeader(header *eth1Types.Header, err error) {
+	rejected := &types.RejectedPandoraHeader{
+		HeaderHash: header.Hash(),
+		Reason:     err.Error(),
+		Timestamp:  uint64(time.Now().Unix()),
+	}
+	if header.Number != nil {
+		rejected.BlockNumber = header.Number.Uint64()
+	}
+	var extraData rawPanExtraData
+	if rlp.DecodeBytes(header.Extra, &extraData) == nil {
+		rejected.Slot = extraData.Slot
+	}
+	log.WithField("headerHash", rejected.HeaderHash).WithField("blockNumber", rejected.BlockNumber).
+		WithField("slot", rejected.Slot).WithField("reason", rejected.Reason).
+		Error("Rejected invalid pandora header")
+
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+	s.rejectedHeaders = append(s.rejectedHeaders, rejected)
+	if len(s.rejectedHeaders) > maxRejectedHeaders {
+		s.rejectedHeaders = s.rejectedHeaders[len(s.rejectedHeaders)-maxRejectedHeaders:]
+	}
+}
+
+// RejectedHeaders returns the latest rejected pandora headers, oldest first
+func (s *Service) RejectedHeaders() []*types.RejectedPandoraHeader {
+	s.processingLock.RLock()
+	defer s.processingLock.RUnlock()
+
+	rejectedHeaders := make([]*types.RejectedPandoraHeader, len(s.rejectedHeaders))
+	copy(rejectedHeaders, s.rejectedHeaders)
+	return rejectedHeaders
+}
@@ -0,0 +1,144 @@
+package pandorachain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+// newHeaderWithSignature creates header of the given slot whose extra data carries the given signature bytes
+func newHeaderWithSignature(t *testing.T, slot uint64, signature []byte) *eth1Types.Header {
+	header := testutil.NewEth1Header(slot)
+	extraData, err := rlp.EncodeToBytes(rawPanExtraData{
+		ExtraData: types.ExtraData{Slot: slot, Epoch: slot / 32},
+		Signature: signature,
+	})
+	require.NoError(t, err)
+	header.Extra = extraData
+	return header
+}
+
+func TestService_ValidateHeader(t *testing.T) {
+	ctx := context.Background()
+	panSvc := SetupPandoraSvc(ctx, t, nil)
+
+	// current slot is 0 while epoch 0 has just started
+	require.NoError(t, panSvc.db.SaveConsensusInfo(ctx, &types.MinimalEpochConsensusInfo{
+		Epoch:            0,
+		ValidatorList:    testutil.NewMinimalConsensusInfo(0).ValidatorList,
+		EpochStartTime:   uint64(time.Now().Unix()),
+		SlotTimeDuration: 6,
+	}))
+
+	zeroNumberHeader := testutil.NewEth1Header(1)
+	zeroNumberHeader.Number = big.NewInt(0)
+	nilNumberHeader := testutil.NewEth1Header(1)
+	nilNumberHeader.Number = nil
+	malformedHeader := testutil.NewEth1Header(1)
+	malformedHeader.Extra = []byte("malformed extra data")
+
+	tests := []struct {
+		name   string
+		header *eth1Types.Header
+		err    error
+	}{
+		{name: "valid header", header: testutil.NewEth1Header(1)},
+		{name: "slot within allowed drift", header: testutil.NewEth1Header(maxFutureSlots)},
+		{name: "undecodable extra data", header: malformedHeader, err: errInvalidExtraData},
+		{name: "zero block number", header: zeroNumberHeader, err: errInvalidBlockNumber},
+		{name: "missing block number", header: nilNumberHeader, err: errInvalidBlockNumber},
+		{name: "short signature", header: newHeaderWithSignature(t, 1, make([]byte, 48)), err: errInvalidSignatureLen},
+		{name: "empty signature", header: newHeaderWithSignature(t, 1, make([]byte, types.BLSSignatureSize)), err: errEmptySignature},
+		{name: "future slot", header: testutil.NewEth1Header(maxFutureSlots + 1), err: errFutureSlot},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extraData, err := panSvc.verifyHeader(tt.header)
+			if tt.err != nil {
+				assert.ErrorContains(t, tt.err.Error(), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.header.Number.Uint64(), extraData.Slot)
+		})
+	}
+}
+
+func TestService_OnNewPendingHeader_RejectsInvalidHeader(t *testing.T) {
+	ctx := context.Background()
+	panSvc := SetupPandoraSvc(ctx, t, nil)
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 1)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	invalidHeader := newHeaderWithSignature(t, 5, make([]byte, types.BLSSignatureSize))
+	panSvc.OnNewPendingHeader(ctx, invalidHeader)
+	select {
+	case headerInfo := <-headerInfoCh:
+		t.Fatalf("rejected header %s has been sent to consensus", headerInfo.Header.Hash().Hex())
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	rejectedHeaders := panSvc.RejectedHeaders()
+	require.Equal(t, 1, len(rejectedHeaders))
+	assert.Equal(t, invalidHeader.Hash(), rejectedHeaders[0].HeaderHash)
+	assert.Equal(t, uint64(5), rejectedHeaders[0].BlockNumber)
+	assert.Equal(t, uint64(5), rejectedHeaders[0].Slot)
+	assert.Equal(t, errEmptySignature.Error(), rejectedHeaders[0].Reason)
+
+	// only the latest rejected headers are kept
+	for i := 0; i < maxRejectedHeaders; i++ {
+		panSvc.recordRejectedHeader(testutil.NewEth1Header(uint64(i+1)), errFutureSlot)
+	}
+	rejectedHeaders = panSvc.RejectedHeaders()
+	require.Equal(t, maxRejectedHeaders, len(rejectedHeaders))
+	assert.Equal(t, uint64(1), rejectedHeaders[0].BlockNumber)
+}
+
+// unreadableConsensusInfoDB fails to read consensus info as a db with transient read error does
+type unreadableConsensusInfoDB struct {
+	db.Database
+}
+
+func (d *unreadableConsensusInfoDB) ConsensusInfo(ctx context.Context, epoch uint64) (*types.MinimalEpochConsensusInfo, error) {
+	return nil, errors.New("db is not readable")
+}
+
+func TestService_OnNewPendingHeader_UnreadableCurrentSlot(t *testing.T) {
+	ctx := context.Background()
+	panSvc := SetupPandoraSvc(ctx, t, nil)
+	panSvc.db = &unreadableConsensusInfoDB{Database: panSvc.db}
+
+	headerInfoCh := make(chan *types.PandoraHeaderInfo, 1)
+	headerInfoSub := panSvc.SubscribeHeaderInfoEvent(headerInfoCh)
+	defer headerInfoSub.Unsubscribe()
+
+	// failing to read db is not a reason to reject the header
+	header := testutil.NewEth1Header(5)
+	panSvc.OnNewPendingHeader(ctx, header)
+	select {
+	case headerInfo := <-headerInfoCh:
+		assert.Equal(t, header.Hash(), headerInfo.Header.Hash())
+		assert.Equal(t, uint64(5), headerInfo.Slot)
+	case <-time.After(time.Second):
+		t.Fatal("valid header has not been sent to consensus")
+	}
+	assert.Equal(t, 0, len(panSvc.RejectedHeaders()))
+
+	// header is still rejected for its own fault
+	panSvc.OnNewPendingHeader(ctx, newHeaderWithSignature(t, 6, make([]byte, types.BLSSignatureSize)))
+	rejectedHeaders := panSvc.RejectedHeaders()
+	require.Equal(t, 1, len(rejectedHeaders))
+	assert.Equal(t, errEmptySignature.Error(), rejectedHeaders[0].Reason)
+}
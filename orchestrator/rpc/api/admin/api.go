@@ -0,0 +1,160 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/connection"
+	"github.com/lukso-network/lukso-orchestrator/shared/version"
+)
+
+type Backend interface {
+	ServiceStatuses() map[string]error
+	VanguardConnectionStats() *connection.Stats
+	ActiveVanguardEndpoint() string
+	PandoraConnectionStats() *connection.Stats
+	DatabasePath() string
+	DatabaseSize() (uint64, error)
+	PendingCacheSizes() (vanguardShardInfos int, pandoraHeaders int)
+}
+
+// PrivateAdminAPI exposes the health of a running orchestrator node. It is only served over IPC unless
+// the admin module is enabled for http or websocket.
+type PrivateAdminAPI struct {
+	backend   Backend
+	startTime time.Time
+}
+
+// ServiceStatus is the status of a registered service. Error is empty when the service is healthy
+type ServiceStatus struct {
+	Service string `json:"service"`
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+}
+
+// ConnectionStatus is the state and counters of a chain node connection. Endpoint is the vanguard
+// endpoint in use, it is empty for pandora and while vanguard is not connected
+type ConnectionStatus struct {
+	State               string         `json:"state"`
+	Endpoint            string         `json:"endpoint,omitempty"`
+	Attempts            hexutil.Uint64 `json:"attempts"`
+	Failures            hexutil.Uint64 `json:"failures"`
+	ConsecutiveFailures hexutil.Uint64 `json:"consecutiveFailures"`
+	Successes           hexutil.Uint64 `json:"successes"`
+	Disconnects         hexutil.Uint64 `json:"disconnects"`
+	LastError           string         `json:"lastError,omitempty"`
+	LastTransition      hexutil.Uint64 `json:"lastTransition"`
+}
+
+// Connections holds the connection status of vanguard and pandora. A status is nil when the service is not running
+type Connections struct {
+	Vanguard *ConnectionStatus `json:"vanguard"`
+	Pandora  *ConnectionStatus `json:"pandora"`
+}
+
+// DatabaseInfo is the location and size of the database. Path is empty for the in-memory database
+type DatabaseInfo struct {
+	Path string         `json:"path"`
+	Size hexutil.Uint64 `json:"size"`
+}
+
+// PendingCacheSizes is the number of entries which are waiting for verification
+type PendingCacheSizes struct {
+	VanguardShardInfos hexutil.Uint64 `json:"vanguardShardInfos"`
+	PandoraHeaders     hexutil.Uint64 `json:"pandoraHeaders"`
+}
+
+// NodeInfo is the version and uptime of the node. Uptime is in seconds
+type NodeInfo struct {
+	Version   string         `json:"version"`
+	StartTime hexutil.Uint64 `json:"startTime"`
+	Uptime    hexutil.Uint64 `json:"uptime"`
+}
+
+// NewPrivateAdminAPI returns a new PrivateAdminAPI instance. Uptime is counted from its creation
+func NewPrivateAdminAPI(backend Backend) *PrivateAdminAPI {
+	return &PrivateAdminAPI{
+		backend:   backend,
+		startTime: time.Now(),
+	}
+}
+
+// Services returns the status of every registered service sorted by service name
+func (api *PrivateAdminAPI) Services(ctx context.Context) []*ServiceStatus {
+	statuses := api.backend.ServiceStatuses()
+	services := make([]*ServiceStatus, 0, len(statuses))
+	for service, err := range statuses {
+		status := &ServiceStatus{Service: service, Healthy: err == nil}
+		if err != nil {
+			status.Error = err.Error()
+		}
+		services = append(services, status)
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Service < services[j].Service })
+	return services
+}
+
+// Connections returns the connection status of vanguard and pandora
+func (api *PrivateAdminAPI) Connections(ctx context.Context) *Connections {
+	connections := &Connections{
+		Vanguard: newConnectionStatus(api.backend.VanguardConnectionStats()),
+		Pandora:  newConnectionStatus(api.backend.PandoraConnectionStats()),
+	}
+	if connections.Vanguard != nil {
+		connections.Vanguard.Endpoint = api.backend.ActiveVanguardEndpoint()
+	}
+	return connections
+}
+
+// Database returns the path and the size of the database
+func (api *PrivateAdminAPI) Database(ctx context.Context) (*DatabaseInfo, error) {
+	size, err := api.backend.DatabaseSize()
+	if err != nil {
+		log.WithError(err).Error("Failed to compute database size")
+		return nil, err
+	}
+	return &DatabaseInfo{
+		Path: api.backend.DatabasePath(),
+		Size: hexutil.Uint64(size),
+	}, nil
+}
+
+// PendingCaches returns the sizes of the pending vanguard sharding info and pandora header caches
+func (api *PrivateAdminAPI) PendingCaches(ctx context.Context) *PendingCacheSizes {
+	vanguardShardInfos, pandoraHeaders := api.backend.PendingCacheSizes()
+	return &PendingCacheSizes{
+		VanguardShardInfos: hexutil.Uint64(vanguardShardInfos),
+		PandoraHeaders:     hexutil.Uint64(pandoraHeaders),
+	}
+}
+
+// NodeInfo returns the version and the uptime of the node
+func (api *PrivateAdminAPI) NodeInfo(ctx context.Context) *NodeInfo {
+	return &NodeInfo{
+		Version:   version.Version(),
+		StartTime: hexutil.Uint64(api.startTime.Unix()),
+		Uptime:    hexutil.Uint64(time.Since(api.startTime) / time.Second),
+	}
+}
+
+// newConnectionStatus
+func newConnectionStatus(stats *connection.Stats) *ConnectionStatus {
+	if stats == nil {
+		return nil
+	}
+	status := &ConnectionStatus{
+		State:               stats.State.String(),
+		Attempts:            hexutil.Uint64(stats.Attempts),
+		Failures:            hexutil.Uint64(stats.Failures),
+		ConsecutiveFailures: hexutil.Uint64(stats.ConsecutiveFailures),
+		Successes:           hexutil.Uint64(stats.Successes),
+		Disconnects:         hexutil.Uint64(stats.Disconnects),
+		LastError:           stats.LastError,
+	}
+	if !stats.LastTransition.IsZero() {
+		status.LastTransition = hexutil.Uint64(stats.LastTransition.Unix())
+	}
+	return status
+}
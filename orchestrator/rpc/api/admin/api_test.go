@@ -0,0 +1,92 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/connection"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+)
+
+type mockBackend struct {
+	statuses      map[string]error
+	vanguardStats *connection.Stats
+	endpoint      string
+	pandoraStats  *connection.Stats
+	dbPath        string
+	dbSize        uint64
+	dbErr         error
+}
+
+func (mb *mockBackend) ServiceStatuses() map[string]error          { return mb.statuses }
+func (mb *mockBackend) VanguardConnectionStats() *connection.Stats { return mb.vanguardStats }
+func (mb *mockBackend) ActiveVanguardEndpoint() string             { return mb.endpoint }
+func (mb *mockBackend) PandoraConnectionStats() *connection.Stats  { return mb.pandoraStats }
+func (mb *mockBackend) DatabasePath() string                       { return mb.dbPath }
+func (mb *mockBackend) DatabaseSize() (uint64, error)              { return mb.dbSize, mb.dbErr }
+func (mb *mockBackend) PendingCacheSizes() (int, int)              { return 3, 7 }
+
+func TestPrivateAdminAPI_Services(t *testing.T) {
+	backend := &mockBackend{statuses: map[string]error{
+		"*vanguardchain.Service": errors.New("vanguard connection is connecting"),
+		"*consensus.Service":     nil,
+	}}
+	adminApi := NewPrivateAdminAPI(backend)
+
+	assert.DeepEqual(t, []*ServiceStatus{
+		{Service: "*consensus.Service", Healthy: true},
+		{Service: "*vanguardchain.Service", Healthy: false, Error: "vanguard connection is connecting"},
+	}, adminApi.Services(context.Background()))
+}
+
+func TestPrivateAdminAPI_Connections(t *testing.T) {
+	transition := time.Unix(1620000000, 0)
+	backend := &mockBackend{vanguardStats: &connection.Stats{
+		State:               connection.Subscribed,
+		Attempts:            3,
+		Failures:            2,
+		ConsecutiveFailures: 0,
+		Successes:           1,
+		LastError:           "connection refused",
+		LastTransition:      transition,
+	}, endpoint: "127.0.0.1:4000"}
+	adminApi := NewPrivateAdminAPI(backend)
+
+	connections := adminApi.Connections(context.Background())
+	assert.DeepEqual(t, &ConnectionStatus{
+		State:          connection.Subscribed.String(),
+		Endpoint:       "127.0.0.1:4000",
+		Attempts:       3,
+		Failures:       2,
+		Successes:      1,
+		LastError:      "connection refused",
+		LastTransition: hexutil.Uint64(transition.Unix()),
+	}, connections.Vanguard)
+	assert.Equal(t, (*ConnectionStatus)(nil), connections.Pandora)
+}
+
+func TestPrivateAdminAPI_NodeInfo(t *testing.T) {
+	backend := &mockBackend{dbPath: "/tmp/orchestrator", dbSize: 1024}
+	adminApi := NewPrivateAdminAPI(backend)
+	ctx := context.Background()
+
+	database, err := adminApi.Database(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &DatabaseInfo{Path: "/tmp/orchestrator", Size: 1024}, database)
+
+	backend.dbErr = errors.New("permission denied")
+	_, err = adminApi.Database(ctx)
+	assert.ErrorContains(t, "permission denied", err)
+
+	assert.DeepEqual(t, &PendingCacheSizes{VanguardShardInfos: 3, PandoraHeaders: 7}, adminApi.PendingCaches(ctx))
+
+	adminApi.startTime = time.Now().Add(-time.Minute)
+	nodeInfo := adminApi.NodeInfo(ctx)
+	assert.NotEqual(t, "", nodeInfo.Version)
+	assert.Equal(t, hexutil.Uint64(adminApi.startTime.Unix()), nodeInfo.StartTime)
+	assert.Equal(t, true, nodeInfo.Uptime >= 60)
+}
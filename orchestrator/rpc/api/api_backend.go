@@ -11,8 +11,13 @@ import (
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/cache"
 	conIface "github.com/lukso-network/lukso-orchestrator/orchestrator/consensus/iface"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
+	panIface "github.com/lukso-network/lukso-orchestrator/orchestrator/pandorachain/iface"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/vanguardchain/iface"
+	"github.com/lukso-network/lukso-orchestrator/shared/connection"
 	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"os"
+	"path/filepath"
+	"reflect"
 )
 
 var ErrHeaderHashMisMatch = errors.New("header hash mismatched")
@@ -21,15 +26,38 @@ type Backend struct {
 	// feed
 	ConsensusInfoFeed    iface.ConsensusInfoFeed
 	VerifiedSlotInfoFeed conIface.VerifiedSlotInfoFeed
+	ReorgFeed            iface.ReorgFeed
+
+	// vanguard sync status, missed blocks backfill and rejected consensus infos
+	SyncStatusProvider       iface.SyncStatusProvider
+	BackfillProgressProvider iface.BackfillProgressProvider
+	ViolationProvider        iface.ConsensusInfoViolationProvider
+
+	// pandora sync status and headers which have been rejected on arrival
+	PandoraSyncStatusProvider panIface.SyncStatusProvider
+	RejectedHeaderProvider    panIface.RejectedHeaderProvider
 
 	// db reference
 	ConsensusInfoDB    db.ROnlyConsensusInfoDB
 	VerifiedSlotInfoDB db.ROnlyVerifiedSlotInfoDB
 	InvalidSlotInfoDB  db.ROnlyInvalidSlotInfoDB
+	ReorgJournalDB     db.ROnlyReorgJournalDB
 
 	// cache reference
 	VanguardPendingShardingCache cache.VanguardShardCache
 	PandoraPendingHeaderCache    cache.PandoraHeaderCache
+
+	// node health for the admin namespace
+	ServiceStatusProvider ServiceStatusProvider
+	VanguardConnection    connection.StatsProvider
+	VanguardEndpoint      iface.EndpointProvider
+	PandoraConnection     connection.StatsProvider
+	DBPath                string
+}
+
+// ServiceStatusProvider returns the status of every registered service
+type ServiceStatusProvider interface {
+	Statuses() map[reflect.Type]error
 }
 
 func (backend *Backend) SubscribeNewEpochEvent(ch chan<- *types.MinimalEpochConsensusInfoV2) event.Subscription {
@@ -40,10 +68,58 @@ func (backend *Backend) SubscribeNewVerifiedSlotInfoEvent(ch chan<- *types.SlotI
 	return backend.VerifiedSlotInfoFeed.SubscribeVerifiedSlotInfoEvent(ch)
 }
 
-func (backend *Backend) ConsensusInfoByEpochRange(fromEpoch uint64) ([]*types.MinimalEpochConsensusInfoV2, error) {
-	consensusInfosV2, err := backend.ConsensusInfoDB.ConsensusInfos(fromEpoch)
+func (backend *Backend) SubscribeNewReorgEvent(ch chan<- *types.ReorgRecord) event.Subscription {
+	return backend.ReorgFeed.SubscribeReorgEvent(ch)
+}
+
+// VanguardSyncStatus returns how far orchestrator lags behind vanguard. It is nil when it is not known yet
+func (backend *Backend) VanguardSyncStatus() *types.VanguardSyncStatus {
+	if backend.SyncStatusProvider == nil {
+		return nil
+	}
+	return backend.SyncStatusProvider.SyncStatus()
+}
+
+// VanguardBackfillProgress returns the progress of the latest missed vanguard blocks backfill. It is nil
+// when no backfill has been needed so far
+func (backend *Backend) VanguardBackfillProgress() *types.BackfillProgress {
+	if backend.BackfillProgressProvider == nil {
+		return nil
+	}
+	return backend.BackfillProgressProvider.BackfillProgress()
+}
+
+// ConsensusInfoViolations returns the latest consensus infos which have been rejected by validation
+func (backend *Backend) ConsensusInfoViolations() []*types.ConsensusInfoViolation {
+	if backend.ViolationProvider == nil {
+		return nil
+	}
+	return backend.ViolationProvider.ConsensusInfoViolations()
+}
+
+// PandoraSyncStatus returns pandora's sync state and how far orchestrator lags behind pandora's head.
+// It is nil when it is not known yet
+func (backend *Backend) PandoraSyncStatus() *types.PandoraSyncStatus {
+	if backend.PandoraSyncStatusProvider == nil {
+		return nil
+	}
+	return backend.PandoraSyncStatusProvider.SyncStatus()
+}
+
+// RejectedPandoraHeaders returns the latest pandora headers which have been rejected by validation
+func (backend *Backend) RejectedPandoraHeaders() []*types.RejectedPandoraHeader {
+	if backend.RejectedHeaderProvider == nil {
+		return nil
+	}
+	return backend.RejectedHeaderProvider.RejectedHeaders()
+}
+
+// ConsensusInfoByEpochRange returns stored consensus infos from the given epoch to the latest epoch
+// together with the epochs which are missing in that range
+func (backend *Backend) ConsensusInfoByEpochRange(fromEpoch uint64) ([]*types.MinimalEpochConsensusInfoV2, []uint64, error) {
+	consensusInfosV2, missingEpochs, err := backend.ConsensusInfoDB.ConsensusInfosWithGaps(fromEpoch)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	epochInfos := make([]*types.MinimalEpochConsensusInfoV2, len(consensusInfosV2))
@@ -51,7 +127,7 @@ func (backend *Backend) ConsensusInfoByEpochRange(fromEpoch uint64) ([]*types.Mi
 		epochInfoV1 := epochInfo.ConvertToEpochInfoV2()
 		epochInfos[i] = epochInfoV1
 	}
-	return epochInfos, nil
+	return epochInfos, missingEpochs, nil
 }
 
 func (backend *Backend) VerifiedSlotInfos(fromSlot uint64) map[uint64]*types.SlotInfo {
@@ -78,6 +154,48 @@ func (backed *Backend) PendingPandoraHeaders() []*eth1Types.Header {
 	return headers
 }
 
+// VerifiedSlotInfoByPandoraHeaderHash returns verified slot number and slot info which holds the given
+// pandora header hash. Returned slot info is nil when the hash is not found in verified slot info db.
+func (backend *Backend) VerifiedSlotInfoByPandoraHeaderHash(hash common.Hash) (uint64, *types.SlotInfo, error) {
+	slot, found, err := backend.VerifiedSlotInfoDB.VerifiedSlotByPandoraHeaderHash(hash)
+	if err != nil || !found {
+		return 0, nil, err
+	}
+	slotInfo, err := backend.VerifiedSlotInfoDB.VerifiedSlotInfo(slot)
+	return slot, slotInfo, err
+}
+
+// VerifiedSlotInfoByVanguardBlockHash returns verified slot number and slot info which holds the given
+// vanguard block hash. Returned slot info is nil when the hash is not found in verified slot info db.
+func (backend *Backend) VerifiedSlotInfoByVanguardBlockHash(hash common.Hash) (uint64, *types.SlotInfo, error) {
+	slot, found, err := backend.VerifiedSlotInfoDB.VerifiedSlotByVanguardBlockHash(hash)
+	if err != nil || !found {
+		return 0, nil, err
+	}
+	slotInfo, err := backend.VerifiedSlotInfoDB.VerifiedSlotInfo(slot)
+	return slot, slotInfo, err
+}
+
+// ReorgRecords returns journaled reorgs which happened at or after fromTime and affected slots at or after fromSlot
+func (backend *Backend) ReorgRecords(fromTime, fromSlot uint64) ([]*types.ReorgRecord, error) {
+	return backend.ReorgJournalDB.ReorgRecords(fromTime, fromSlot)
+}
+
+// ConsensusInfoRange returns at most limit consensus infos from fromEpoch to toEpoch in ascending order
+func (backend *Backend) ConsensusInfoRange(fromEpoch, toEpoch uint64, limit int) ([]*types.MinimalEpochConsensusInfo, error) {
+	return backend.ConsensusInfoDB.ConsensusInfoRange(fromEpoch, toEpoch, limit)
+}
+
+// VerifiedSlotInfoRange returns at most limit verified slot infos from fromSlot to toSlot in ascending order
+func (backend *Backend) VerifiedSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*types.SlotInfoWithSlot, error) {
+	return backend.VerifiedSlotInfoDB.VerifiedSlotInfoRange(fromSlot, toSlot, limit)
+}
+
+// InvalidSlotInfoRange returns at most limit invalid slot infos from fromSlot to toSlot in ascending order
+func (backend *Backend) InvalidSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*types.SlotInfoWithSlot, error) {
+	return backend.InvalidSlotInfoDB.InvalidSlotInfoRange(fromSlot, toSlot, limit)
+}
+
 // GetSlotStatus
 func (backend *Backend) GetSlotStatus(ctx context.Context, slot uint64, hash common.Hash, requestFrom bool) types.Status {
 	// by default if nothing is found then return skipped
@@ -126,3 +244,76 @@ func (backend *Backend) GetSlotStatus(ctx context.Context, slot uint64, hash com
 	logPrinter(status)
 	return status
 }
+
+// ServiceStatuses returns the status of every registered service keyed by the service type name
+func (backend *Backend) ServiceStatuses() map[string]error {
+	statuses := make(map[string]error)
+	if backend.ServiceStatusProvider == nil {
+		return statuses
+	}
+	for kind, err := range backend.ServiceStatusProvider.Statuses() {
+		statuses[kind.String()] = err
+	}
+	return statuses
+}
+
+// VanguardConnectionStats returns the vanguard connection counters. It is nil when there is no vanguard service
+func (backend *Backend) VanguardConnectionStats() *connection.Stats {
+	if backend.VanguardConnection == nil {
+		return nil
+	}
+	stats := backend.VanguardConnection.ConnectionStats()
+	return &stats
+}
+
+// ActiveVanguardEndpoint returns the vanguard endpoint which is in use. It is empty when orchestrator is
+// not connected to vanguard
+func (backend *Backend) ActiveVanguardEndpoint() string {
+	if backend.VanguardEndpoint == nil {
+		return ""
+	}
+	return backend.VanguardEndpoint.ActiveEndpoint()
+}
+
+// PandoraConnectionStats returns the pandora connection counters. It is nil when there is no pandora service
+func (backend *Backend) PandoraConnectionStats() *connection.Stats {
+	if backend.PandoraConnection == nil {
+		return nil
+	}
+	stats := backend.PandoraConnection.ConnectionStats()
+	return &stats
+}
+
+// DatabasePath returns the directory of the database. It is empty for the in-memory database
+func (backend *Backend) DatabasePath() string {
+	return backend.DBPath
+}
+
+// DatabaseSize returns the total size in bytes of the files in the database directory
+func (backend *Backend) DatabaseSize() (uint64, error) {
+	if backend.DBPath == "" {
+		return 0, nil
+	}
+	var size uint64
+	err := filepath.Walk(backend.DBPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += uint64(info.Size())
+		}
+		return nil
+	})
+	return size, err
+}
+
+// PendingCacheSizes returns the number of vanguard sharding infos and pandora headers waiting for verification
+func (backend *Backend) PendingCacheSizes() (vanguardShardInfos int, pandoraHeaders int) {
+	if backend.VanguardPendingShardingCache != nil {
+		vanguardShardInfos = backend.VanguardPendingShardingCache.Len()
+	}
+	if backend.PandoraPendingHeaderCache != nil {
+		pandoraHeaders = backend.PandoraPendingHeaderCache.Len()
+	}
+	return
+}
@@ -1 +1,50 @@
 package api
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/cache"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+)
+
+func TestBackend_DatabaseSize(t *testing.T) {
+	backend := &Backend{}
+	size, err := backend.DatabaseSize()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), size)
+
+	backend.DBPath = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(backend.DBPath, "orchestrator.db"), make([]byte, 100), 0600))
+	require.NoError(t, os.Mkdir(filepath.Join(backend.DBPath, "backups"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(backend.DBPath, "backups", "backup.db"), make([]byte, 20), 0600))
+	size, err = backend.DatabaseSize()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(120), size)
+
+	backend.DBPath = filepath.Join(backend.DBPath, "missing")
+	_, err = backend.DatabaseSize()
+	assert.NotNil(t, err)
+}
+
+func TestBackend_PendingCacheSizes(t *testing.T) {
+	backend := &Backend{}
+	vanguardShardInfos, pandoraHeaders := backend.PendingCacheSizes()
+	assert.Equal(t, 0, vanguardShardInfos)
+	assert.Equal(t, 0, pandoraHeaders)
+
+	ctx := context.Background()
+	backend.VanguardPendingShardingCache = cache.NewVanShardInfoCache(1 << 10)
+	backend.PandoraPendingHeaderCache = cache.NewPanHeaderCache()
+	for slot := uint64(1); slot <= 3; slot++ {
+		require.NoError(t, backend.PandoraPendingHeaderCache.Put(ctx, slot, testutil.NewEth1Header(slot)))
+	}
+	require.NoError(t, backend.VanguardPendingShardingCache.Put(ctx, 1, nil))
+	vanguardShardInfos, pandoraHeaders = backend.PendingCacheSizes()
+	assert.Equal(t, 1, vanguardShardInfos)
+	assert.Equal(t, 3, pandoraHeaders)
+}
@@ -15,8 +15,13 @@ import (
 
 var lastSendEpoch uint64
 
+// missingEpochPollPeriod is the interval to check whether missing epoch infos have been backfilled
+var missingEpochPollPeriod = time.Second
+
+var errSubscriptionClosed = errors.New("subscription has been closed")
+
 type Backend interface {
-	ConsensusInfoByEpochRange(fromEpoch uint64) ([]*generalTypes.MinimalEpochConsensusInfoV2, error)
+	ConsensusInfoByEpochRange(fromEpoch uint64) ([]*generalTypes.MinimalEpochConsensusInfoV2, []uint64, error)
 	SubscribeNewEpochEvent(chan<- *generalTypes.MinimalEpochConsensusInfoV2) event.Subscription
 	GetSlotStatus(ctx context.Context, slot uint64, hash common.Hash, requestFrom bool) generalTypes.Status
 	LatestEpoch() uint64
@@ -24,6 +29,18 @@ type Backend interface {
 	VerifiedSlotInfos(fromSlot uint64) map[uint64]*generalTypes.SlotInfo
 	LatestVerifiedSlot() uint64
 	PendingPandoraHeaders() []*eth1Types.Header
+	VerifiedSlotInfoByPandoraHeaderHash(hash common.Hash) (uint64, *generalTypes.SlotInfo, error)
+	VerifiedSlotInfoByVanguardBlockHash(hash common.Hash) (uint64, *generalTypes.SlotInfo, error)
+	SubscribeNewReorgEvent(chan<- *generalTypes.ReorgRecord) event.Subscription
+	ReorgRecords(fromTime, fromSlot uint64) ([]*generalTypes.ReorgRecord, error)
+	ConsensusInfoRange(fromEpoch, toEpoch uint64, limit int) ([]*generalTypes.MinimalEpochConsensusInfo, error)
+	VerifiedSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*generalTypes.SlotInfoWithSlot, error)
+	InvalidSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*generalTypes.SlotInfoWithSlot, error)
+	VanguardSyncStatus() *generalTypes.VanguardSyncStatus
+	VanguardBackfillProgress() *generalTypes.BackfillProgress
+	ConsensusInfoViolations() []*generalTypes.ConsensusInfoViolation
+	PandoraSyncStatus() *generalTypes.PandoraSyncStatus
+	RejectedPandoraHeaders() []*generalTypes.RejectedPandoraHeader
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -117,28 +134,47 @@ func (api *PublicFilterAPI) MinimalConsensusInfo(ctx context.Context, requestedE
 
 	go func() {
 
+		// batchSender sends stored epoch infos in order. When an epoch is missing, it blocks until
+		// the gap is filled by vanguard backfill instead of skipping it.
 		batchSender := func(start, end uint64) error {
-			epochInfos, err := api.backend.ConsensusInfoByEpochRange(start)
-			if err != nil {
-				log.WithError(err).Error("Some epoch infos are missing in db.")
-				return errors.Wrap(err, "Missing epoch infos in db. Could not send over stream.")
-			}
-			for _, ei := range epochInfos {
-				if err := notifier.Notify(rpcSub.ID, &generalTypes.MinimalEpochConsensusInfoV2{
-					Epoch:            ei.Epoch,
-					ValidatorList:    ei.ValidatorList,
-					EpochStartTime:   ei.EpochStartTime,
-					SlotTimeDuration: ei.SlotTimeDuration,
-				}); err != nil {
-					log.WithField("start", start).
-						WithField("end", end).
-						WithError(err).
-						Error("Failed to send epoch info. Could not send over stream.")
-					return errors.Wrap(err, "Failed to send epoch info. Could not send over stream.")
+			for {
+				epochInfos, missingEpochs, err := api.backend.ConsensusInfoByEpochRange(start)
+				if err != nil {
+					log.WithError(err).Error("Some epoch infos are missing in db.")
+					return errors.Wrap(err, "Missing epoch infos in db. Could not send over stream.")
+				}
+				for _, ei := range epochInfos {
+					if len(missingEpochs) > 0 && ei.Epoch > missingEpochs[0] {
+						break
+					}
+					if err := notifier.Notify(rpcSub.ID, &generalTypes.MinimalEpochConsensusInfoV2{
+						Epoch:            ei.Epoch,
+						ValidatorList:    ei.ValidatorList,
+						EpochStartTime:   ei.EpochStartTime,
+						SlotTimeDuration: ei.SlotTimeDuration,
+					}); err != nil {
+						log.WithField("start", start).
+							WithField("end", end).
+							WithError(err).
+							Error("Failed to send epoch info. Could not send over stream.")
+						return errors.Wrap(err, "Failed to send epoch info. Could not send over stream.")
+					}
+					log.WithField("epoch", ei.Epoch).Info("published epoch info to pandora")
+					start = ei.Epoch + 1
+				}
+				if len(missingEpochs) == 0 {
+					return nil
+				}
+
+				log.WithField("missingEpochs", missingEpochs).Warn("Waiting for missing epoch infos to be backfilled")
+				select {
+				case <-time.After(missingEpochPollPeriod):
+				case <-rpcSub.Err():
+					return errSubscriptionClosed
+				case <-notifier.Closed():
+					return errSubscriptionClosed
 				}
-				log.WithField("epoch", ei.Epoch).Info("published epoch info to pandora")
 			}
-			return nil
 		}
 
 		startEpoch := requestedEpoch
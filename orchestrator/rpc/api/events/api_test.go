@@ -0,0 +1,126 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lukso-network/lukso-orchestrator/shared/params"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+// Test_MinimalConsensusInfo_Resend_Replaced_Epochs checks that subscriber gets the reorg consensus info and then
+// the epochs of the new branch which replace the abandoned ones, without stale epochs in between
+func Test_MinimalConsensusInfo_Resend_Replaced_Epochs(t *testing.T) {
+	backend, eventApi := setup(t)
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.NoError(t, server.RegisterName("orc", eventApi))
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	consensusInfoCh := make(chan *eventTypes.MinimalEpochConsensusInfoV2)
+	sub, err := client.Subscribe(context.Background(), "orc", consensusInfoCh, "minimalConsensusInfo", 0)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	receive := func(count int) []*eventTypes.MinimalEpochConsensusInfoV2 {
+		consensusInfos := make([]*eventTypes.MinimalEpochConsensusInfoV2, 0, count)
+		for len(consensusInfos) < count {
+			select {
+			case consensusInfo := <-consensusInfoCh:
+				consensusInfos = append(consensusInfos, consensusInfo)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timed out, received %d consensus infos", len(consensusInfos))
+			}
+		}
+		return consensusInfos
+	}
+	epochs := func(consensusInfos []*eventTypes.MinimalEpochConsensusInfoV2) []uint64 {
+		result := make([]uint64, len(consensusInfos))
+		for i, consensusInfo := range consensusInfos {
+			result[i] = consensusInfo.Epoch
+		}
+		return result
+	}
+	assert.DeepEqual(t, []uint64{0, 1, 2, 3, 4}, epochs(receive(5)))
+
+	// wait until live subscription is installed
+	time.Sleep(500 * time.Millisecond)
+	reorgInfo := testutil.NewMinimalConsensusInfo(2)
+	reorgInfo.ReorgInfo = &eventTypes.Reorg{NewSlot: 2*params.SlotsPerEpoch + 2}
+	backend.ConsensusInfoFeed.Send(reorgInfo)
+	received := receive(1)
+	assert.DeepEqual(t, reorgInfo.ReorgInfo, received[0].ReorgInfo)
+
+	// nothing is sent until vanguard supplies the epochs of the new branch
+	select {
+	case consensusInfo := <-consensusInfoCh:
+		t.Fatalf("unexpected consensus info of epoch %d", consensusInfo.Epoch)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	replacements := make([]*eventTypes.MinimalEpochConsensusInfoV2, 0)
+	for epoch := uint64(3); epoch <= 4; epoch++ {
+		replacement := testutil.NewMinimalConsensusInfo(epoch)
+		replacement.ValidatorList = append([]string{}, replacement.ValidatorList...)
+		replacement.ValidatorList[0] = replacement.ValidatorList[len(replacement.ValidatorList)-1]
+		replacements = append(replacements, replacement)
+		backend.ConsensusInfoFeed.Send(replacement)
+	}
+	received = receive(2)
+	assert.DeepEqual(t, []uint64{3, 4}, epochs(received))
+	for i, replacement := range replacements {
+		assert.DeepEqual(t, replacement.ValidatorList, received[i].ValidatorList)
+	}
+}
+
+// Test_MinimalConsensusInfo_Blocks_On_Missing_Epochs checks that subscriber does not get epochs after a gap
+// until the missing epoch has been backfilled
+func Test_MinimalConsensusInfo_Blocks_On_Missing_Epochs(t *testing.T) {
+	defer func(period time.Duration) { missingEpochPollPeriod = period }(missingEpochPollPeriod)
+	missingEpochPollPeriod = 50 * time.Millisecond
+
+	backend, eventApi := setup(t)
+	missingEpochInfo := backend.ConsensusInfos[2]
+	backend.ConsensusInfos = append(backend.ConsensusInfos[:2:2], backend.ConsensusInfos[3:]...)
+	backend.MissingEpochs = []uint64{2}
+
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.NoError(t, server.RegisterName("orc", eventApi))
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	consensusInfoCh := make(chan *eventTypes.MinimalEpochConsensusInfoV2)
+	sub, err := client.Subscribe(context.Background(), "orc", consensusInfoCh, "minimalConsensusInfo", 0)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	receiveEpochs := func(count int) []uint64 {
+		epochs := make([]uint64, 0, count)
+		for len(epochs) < count {
+			select {
+			case consensusInfo := <-consensusInfoCh:
+				epochs = append(epochs, consensusInfo.Epoch)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timed out, received epochs: %v", epochs)
+			}
+		}
+		return epochs
+	}
+	assert.DeepEqual(t, []uint64{0, 1}, receiveEpochs(2))
+
+	select {
+	case consensusInfo := <-consensusInfoCh:
+		t.Fatalf("received epoch %d before missing epoch was backfilled", consensusInfo.Epoch)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	backend.FillMissingEpoch(missingEpochInfo)
+	assert.DeepEqual(t, []uint64{2, 3, 4}, receiveEpochs(3))
+}
@@ -6,6 +6,8 @@ import (
 	eth1Types "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
 	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+	"sort"
+	"sync"
 	"time"
 )
 
@@ -14,22 +16,81 @@ var (
 )
 
 type MockBackend struct {
+	lock sync.Mutex
+
 	ConsensusInfoFeed    event.Feed
 	verifiedSlotInfoFeed event.Feed
+	ReorgFeed            event.Feed
 
 	ConsensusInfos    []*eventTypes.MinimalEpochConsensusInfoV2
 	verifiedSlotInfos map[uint64]*eventTypes.SlotInfo
 	CurEpoch          uint64
+	Reorgs            []*eventTypes.ReorgRecord
+	MissingEpochs     []uint64
+	SyncStatus        *eventTypes.VanguardSyncStatus
+	Backfill          *eventTypes.BackfillProgress
+	Violations        []*eventTypes.ConsensusInfoViolation
+	PanSyncStatus     *eventTypes.PandoraSyncStatus
+	RejectedHeaders   []*eventTypes.RejectedPandoraHeader
+	PendingHeaders    []*eth1Types.Header
+	invalidSlotInfos  map[uint64]*eventTypes.SlotInfo
 }
 
 var _ Backend = &MockBackend{}
 
-func (b *MockBackend) ConsensusInfoByEpochRange(fromEpoch uint64) ([]*eventTypes.MinimalEpochConsensusInfoV2, error) {
+func (b *MockBackend) ConsensusInfoByEpochRange(fromEpoch uint64) ([]*eventTypes.MinimalEpochConsensusInfoV2, []uint64, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
 	consensusInfos := make([]*eventTypes.MinimalEpochConsensusInfoV2, 0)
 	for _, consensusInfo := range b.ConsensusInfos {
-		consensusInfos = append(consensusInfos, consensusInfo)
+		if consensusInfo.Epoch >= fromEpoch {
+			consensusInfos = append(consensusInfos, consensusInfo)
+		}
 	}
-	return consensusInfos, nil
+	missingEpochs := make([]uint64, 0)
+	for _, epoch := range b.MissingEpochs {
+		if epoch >= fromEpoch {
+			missingEpochs = append(missingEpochs, epoch)
+		}
+	}
+	return consensusInfos, missingEpochs, nil
+}
+
+// FillMissingEpoch stores consensus info of a missing epoch like vanguard backfill does
+func (b *MockBackend) FillMissingEpoch(consensusInfo *eventTypes.MinimalEpochConsensusInfoV2) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	missingEpochs := make([]uint64, 0, len(b.MissingEpochs))
+	for _, epoch := range b.MissingEpochs {
+		if epoch != consensusInfo.Epoch {
+			missingEpochs = append(missingEpochs, epoch)
+		}
+	}
+	b.MissingEpochs = missingEpochs
+	b.ConsensusInfos = append(b.ConsensusInfos, consensusInfo)
+	sort.Slice(b.ConsensusInfos, func(i, j int) bool { return b.ConsensusInfos[i].Epoch < b.ConsensusInfos[j].Epoch })
+}
+
+func (b *MockBackend) VanguardSyncStatus() *eventTypes.VanguardSyncStatus {
+	return b.SyncStatus
+}
+
+func (b *MockBackend) VanguardBackfillProgress() *eventTypes.BackfillProgress {
+	return b.Backfill
+}
+
+func (b *MockBackend) ConsensusInfoViolations() []*eventTypes.ConsensusInfoViolation {
+	return b.Violations
+}
+
+func (b *MockBackend) PandoraSyncStatus() *eventTypes.PandoraSyncStatus {
+	return b.PanSyncStatus
+}
+
+func (b *MockBackend) RejectedPandoraHeaders() []*eventTypes.RejectedPandoraHeader {
+	return b.RejectedHeaders
 }
 
 func (b *MockBackend) SubscribeNewEpochEvent(ch chan<- *eventTypes.MinimalEpochConsensusInfoV2) event.Subscription {
@@ -49,7 +110,7 @@ func (mb *MockBackend) LatestEpoch() uint64 {
 }
 
 func (mb *MockBackend) PendingPandoraHeaders() []*eth1Types.Header {
-	return nil
+	return mb.PendingHeaders
 }
 
 func (mb *MockBackend) VerifiedSlotInfos(fromSlot uint64) map[uint64]*eventTypes.SlotInfo {
@@ -63,3 +124,74 @@ func (mb *MockBackend) VerifiedSlotInfos(fromSlot uint64) map[uint64]*eventTypes
 func (mb *MockBackend) LatestVerifiedSlot() uint64 {
 	return 100
 }
+
+func (mb *MockBackend) VerifiedSlotInfoByPandoraHeaderHash(hash common.Hash) (uint64, *eventTypes.SlotInfo, error) {
+	for slot, slotInfo := range mb.verifiedSlotInfos {
+		if slotInfo.PandoraHeaderHash == hash {
+			return slot, slotInfo, nil
+		}
+	}
+	return 0, nil, nil
+}
+
+func (mb *MockBackend) VerifiedSlotInfoByVanguardBlockHash(hash common.Hash) (uint64, *eventTypes.SlotInfo, error) {
+	for slot, slotInfo := range mb.verifiedSlotInfos {
+		if slotInfo.VanguardBlockHash == hash {
+			return slot, slotInfo, nil
+		}
+	}
+	return 0, nil, nil
+}
+
+func (mb *MockBackend) SubscribeNewReorgEvent(ch chan<- *eventTypes.ReorgRecord) event.Subscription {
+	return mb.ReorgFeed.Subscribe(ch)
+}
+
+func (mb *MockBackend) ReorgRecords(fromTime, fromSlot uint64) ([]*eventTypes.ReorgRecord, error) {
+	records := make([]*eventTypes.ReorgRecord, 0)
+	for _, record := range mb.Reorgs {
+		if record.Timestamp >= fromTime && record.HighestSlot() >= fromSlot {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func (mb *MockBackend) ConsensusInfoRange(fromEpoch, toEpoch uint64, limit int) ([]*eventTypes.MinimalEpochConsensusInfo, error) {
+	consensusInfos := make([]*eventTypes.MinimalEpochConsensusInfo, 0)
+	for _, consensusInfo := range mb.ConsensusInfos {
+		if limit > 0 && len(consensusInfos) >= limit {
+			break
+		}
+		if consensusInfo.Epoch >= fromEpoch && consensusInfo.Epoch <= toEpoch {
+			consensusInfos = append(consensusInfos, consensusInfo.ConvertToEpochInfo())
+		}
+	}
+	return consensusInfos, nil
+}
+
+func (mb *MockBackend) VerifiedSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*eventTypes.SlotInfoWithSlot, error) {
+	return mockSlotInfoRange(mb.verifiedSlotInfos, fromSlot, toSlot, limit), nil
+}
+
+func (mb *MockBackend) InvalidSlotInfoRange(fromSlot, toSlot uint64, limit int) ([]*eventTypes.SlotInfoWithSlot, error) {
+	return mockSlotInfoRange(mb.invalidSlotInfos, fromSlot, toSlot, limit), nil
+}
+
+func mockSlotInfoRange(slotInfos map[uint64]*eventTypes.SlotInfo, fromSlot, toSlot uint64, limit int) []*eventTypes.SlotInfoWithSlot {
+	slots := make([]uint64, 0)
+	for slot := range slotInfos {
+		if slot >= fromSlot && slot <= toSlot {
+			slots = append(slots, slot)
+		}
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+	if limit > 0 && len(slots) > limit {
+		slots = slots[:limit]
+	}
+	rangeInfos := make([]*eventTypes.SlotInfoWithSlot, len(slots))
+	for i, slot := range slots {
+		rangeInfos[i] = &eventTypes.SlotInfoWithSlot{Slot: slot, SlotInfo: *slotInfos[slot]}
+	}
+	return rangeInfos
+}
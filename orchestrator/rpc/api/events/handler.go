@@ -23,6 +23,9 @@ const (
 	// VerifiedSlotInfoSubscription triggers when new slot is verified
 	VerifiedSlotInfoSubscription
 
+	// ReorgSubscription triggers when a reorg has been journaled
+	ReorgSubscription
+
 	// LastSubscription keeps track of the last index
 	LastIndexSubscription
 )
@@ -38,6 +41,7 @@ type subscription struct {
 	epoch         uint64 // last served epoch number
 	consensusInfo chan *types.MinimalEpochConsensusInfoV2
 	slotInfo      chan *types.SlotInfoWithStatus
+	reorg         chan *types.ReorgRecord
 }
 
 // EventSystem creates subscriptions, processes events and broadcasts them to the
@@ -48,12 +52,14 @@ type EventSystem struct {
 	// Subscriptions
 	consensusInfoSub    event.Subscription // Subscription for new epoch validator list
 	verifiedSlotInfoSub event.Subscription
+	reorgSub            event.Subscription
 
 	// Channels
 	install         chan *subscription                      // install filter for event notification
 	uninstall       chan *subscription                      // remove filter for event notification
 	consensusInfoCh chan *types.MinimalEpochConsensusInfoV2 // Channel to receive new new consensus info event
 	slotInfoCh      chan *types.SlotInfoWithStatus
+	reorgCh         chan *types.ReorgRecord
 }
 
 // NewEventSystem creates a new manager that listens for event on the given mux,
@@ -69,6 +75,7 @@ func NewEventSystem(backend Backend) *EventSystem {
 		uninstall:       make(chan *subscription),
 		consensusInfoCh: make(chan *types.MinimalEpochConsensusInfoV2, 1),
 		slotInfoCh:      make(chan *types.SlotInfoWithStatus, 1),
+		reorgCh:         make(chan *types.ReorgRecord, 1),
 	}
 
 	// Subscribe events
@@ -82,6 +89,10 @@ func NewEventSystem(backend Backend) *EventSystem {
 	if m.consensusInfoSub == nil {
 		ethLog.Crit("Subscribe for verified slot info event system failed")
 	}
+	m.reorgSub = m.backend.SubscribeNewReorgEvent(m.reorgCh)
+	if m.reorgSub == nil {
+		ethLog.Crit("Subscribe for reorg event system failed")
+	}
 
 	go m.eventLoop()
 	return m
@@ -113,6 +124,7 @@ func (sub *Subscription) Unsubscribe() {
 			case sub.es.uninstall <- sub.f:
 				break uninstallLoop
 			case <-sub.f.consensusInfo:
+			case <-sub.f.reorg:
 			}
 		}
 
@@ -158,6 +170,19 @@ func (es *EventSystem) SubscribeVerifiedSlotInfo(slotInfo chan *types.SlotInfoWi
 	return es.subscribe(sub)
 }
 
+// SubscribeReorg creates a subscription that writes every journaled reorg
+func (es *EventSystem) SubscribeReorg(reorg chan *types.ReorgRecord) *Subscription {
+	sub := &subscription{
+		id:        rpc.NewID(),
+		typ:       ReorgSubscription,
+		created:   time.Now(),
+		installed: make(chan struct{}),
+		err:       make(chan error),
+		reorg:     reorg,
+	}
+	return es.subscribe(sub)
+}
+
 type filterIndex map[Type]map[rpc.ID]*subscription
 
 // handleConsensusInfoEvent
@@ -174,11 +199,19 @@ func (es *EventSystem) handleVerifiedSlotInfoEvent(filters filterIndex, si *type
 	}
 }
 
+// handleReorgEvent
+func (es *EventSystem) handleReorgEvent(filters filterIndex, record *types.ReorgRecord) {
+	for _, f := range filters[ReorgSubscription] {
+		f.reorg <- record
+	}
+}
+
 // eventLoop (un)installs filters and processes mux events.
 func (es *EventSystem) eventLoop() {
 	// Ensure all subscriptions get cleaned up
 	defer func() {
 		es.consensusInfoSub.Unsubscribe()
+		es.reorgSub.Unsubscribe()
 	}()
 
 	index := make(filterIndex)
@@ -192,6 +225,8 @@ func (es *EventSystem) eventLoop() {
 			es.handleConsensusInfoEvent(index, ev)
 		case si := <-es.slotInfoCh:
 			es.handleVerifiedSlotInfoEvent(index, si)
+		case record := <-es.reorgCh:
+			es.handleReorgEvent(index, record)
 		case f := <-es.install:
 			index[f.typ][f.id] = f
 			close(f.installed)
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	generalTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	errInvalidSlotInfoNotFound = errors.New("invalid slot info not found")
+	errConsensusInfoNotFound   = errors.New("consensus info not found")
+)
+
+// ConsensusInfo is the json representation of the minimal consensus info of an epoch.
+// SlotTimeDuration is the number of seconds per slot.
+type ConsensusInfo struct {
+	Epoch            hexutil.Uint64 `json:"epoch"`
+	ValidatorList    []string       `json:"validatorList"`
+	EpochStartTime   hexutil.Uint64 `json:"epochTimeStart"`
+	SlotTimeDuration hexutil.Uint64 `json:"slotTimeDuration"`
+}
+
+// newConsensusInfo
+func newConsensusInfo(consensusInfo *generalTypes.MinimalEpochConsensusInfo) *ConsensusInfo {
+	return &ConsensusInfo{
+		Epoch:            hexutil.Uint64(consensusInfo.Epoch),
+		ValidatorList:    consensusInfo.ValidatorList,
+		EpochStartTime:   hexutil.Uint64(consensusInfo.EpochStartTime),
+		SlotTimeDuration: hexutil.Uint64(consensusInfo.SlotTimeDuration),
+	}
+}
+
+// GetVerifiedSlotInfo returns the verified slot info of the given slot
+func (api *PublicFilterAPI) GetVerifiedSlotInfo(ctx context.Context, slot hexutil.Uint64) (*VerifiedSlotInfo, error) {
+	slotInfos, err := api.backend.VerifiedSlotInfoRange(uint64(slot), uint64(slot), 1)
+	if err != nil {
+		log.WithError(err).WithField("slot", slot).Error("Failed to retrieve verified slot info")
+		return nil, err
+	}
+	if len(slotInfos) == 0 {
+		return nil, errors.Wrapf(errVerifiedSlotInfoNotFound, "slot: %d", slot)
+	}
+	return newVerifiedSlotInfo(slotInfos[0].Slot, &slotInfos[0].SlotInfo), nil
+}
+
+// GetInvalidSlotInfo returns the slot info of the given slot which has been marked as invalid
+func (api *PublicFilterAPI) GetInvalidSlotInfo(ctx context.Context, slot hexutil.Uint64) (*VerifiedSlotInfo, error) {
+	slotInfos, err := api.backend.InvalidSlotInfoRange(uint64(slot), uint64(slot), 1)
+	if err != nil {
+		log.WithError(err).WithField("slot", slot).Error("Failed to retrieve invalid slot info")
+		return nil, err
+	}
+	if len(slotInfos) == 0 {
+		return nil, errors.Wrapf(errInvalidSlotInfoNotFound, "slot: %d", slot)
+	}
+	return newVerifiedSlotInfo(slotInfos[0].Slot, &slotInfos[0].SlotInfo), nil
+}
+
+// GetConsensusInfo returns the minimal consensus info of the given epoch
+func (api *PublicFilterAPI) GetConsensusInfo(ctx context.Context, epoch hexutil.Uint64) (*ConsensusInfo, error) {
+	consensusInfos, err := api.backend.ConsensusInfoRange(uint64(epoch), uint64(epoch), 1)
+	if err != nil {
+		log.WithError(err).WithField("epoch", epoch).Error("Failed to retrieve consensus info")
+		return nil, err
+	}
+	if len(consensusInfos) == 0 {
+		return nil, errors.Wrapf(errConsensusInfoNotFound, "epoch: %d", epoch)
+	}
+	return newConsensusInfo(consensusInfos[0]), nil
+}
+
+// LatestVerifiedSlot returns the latest slot which has been verified
+func (api *PublicFilterAPI) LatestVerifiedSlot(ctx context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(api.backend.LatestVerifiedSlot())
+}
+
+// LatestEpoch returns the epoch of the latest stored consensus info
+func (api *PublicFilterAPI) LatestEpoch(ctx context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(api.backend.LatestEpoch())
+}
+
+// PendingPandoraHeaders returns the pandora headers which are waiting for verification
+func (api *PublicFilterAPI) PendingPandoraHeaders(ctx context.Context) []*eth1Types.Header {
+	headers := api.backend.PendingPandoraHeaders()
+	if headers == nil {
+		headers = make([]*eth1Types.Header, 0)
+	}
+	return headers
+}
@@ -0,0 +1,76 @@
+package events
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+func TestPublicFilterAPI_GetSlotInfo(t *testing.T) {
+	backend, eventApi := setup(t)
+	ctx := context.Background()
+	slotInfo := &eventTypes.SlotInfo{
+		VanguardBlockHash: common.HexToHash("0x6f701e4e8b260f38a43cdc0d97cfdc7f0cd33f58ef26bbc6c327ac87d76304d2"),
+		PandoraHeaderHash: common.HexToHash("0x0846da512db0a6888a59aa5f7235b741e36a9dcacc9dad33ee2a228878aefa74"),
+	}
+	backend.verifiedSlotInfos = map[uint64]*eventTypes.SlotInfo{12: slotInfo}
+	backend.invalidSlotInfos = map[uint64]*eventTypes.SlotInfo{13: slotInfo}
+
+	actual, err := eventApi.GetVerifiedSlotInfo(ctx, 12)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &VerifiedSlotInfo{
+		Slot:              hexutil.Uint64(12),
+		VanguardBlockHash: slotInfo.VanguardBlockHash,
+		PandoraHeaderHash: slotInfo.PandoraHeaderHash,
+	}, actual)
+
+	actual, err = eventApi.GetInvalidSlotInfo(ctx, 13)
+	require.NoError(t, err)
+	assert.Equal(t, hexutil.Uint64(13), actual.Slot)
+
+	_, err = eventApi.GetVerifiedSlotInfo(ctx, 13)
+	assert.ErrorContains(t, errVerifiedSlotInfoNotFound.Error(), err)
+
+	_, err = eventApi.GetInvalidSlotInfo(ctx, 12)
+	assert.ErrorContains(t, errInvalidSlotInfoNotFound.Error(), err)
+}
+
+func TestPublicFilterAPI_GetConsensusInfo(t *testing.T) {
+	backend, eventApi := setup(t)
+	ctx := context.Background()
+
+	actual, err := eventApi.GetConsensusInfo(ctx, 3)
+	require.NoError(t, err)
+	expected := backend.ConsensusInfos[3]
+	assert.DeepEqual(t, &ConsensusInfo{
+		Epoch:            hexutil.Uint64(3),
+		ValidatorList:    expected.ValidatorList,
+		EpochStartTime:   hexutil.Uint64(expected.EpochStartTime),
+		SlotTimeDuration: hexutil.Uint64(expected.SlotTimeDuration),
+	}, actual)
+
+	_, err = eventApi.GetConsensusInfo(ctx, 5)
+	assert.ErrorContains(t, errConsensusInfoNotFound.Error(), err)
+}
+
+func TestPublicFilterAPI_LatestAndPending(t *testing.T) {
+	backend, eventApi := setup(t)
+	ctx := context.Background()
+
+	assert.Equal(t, hexutil.Uint64(100), eventApi.LatestVerifiedSlot(ctx))
+	assert.Equal(t, hexutil.Uint64(100), eventApi.LatestEpoch(ctx))
+
+	headers := eventApi.PendingPandoraHeaders(ctx)
+	require.NotNil(t, headers)
+	assert.Equal(t, 0, len(headers))
+
+	backend.PendingHeaders = []*eth1Types.Header{{Number: big.NewInt(1)}}
+	assert.DeepEqual(t, backend.PendingHeaders, eventApi.PendingPandoraHeaders(ctx))
+}
@@ -0,0 +1,130 @@
+package events
+
+import (
+	"context"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	generalTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// defaultPageSize is used when a range request does not set a limit
+	defaultPageSize = 100
+	// maxPageSize caps the number of entries in a single page
+	maxPageSize = 1000
+)
+
+var errInvalidContinuation = errors.New("invalid continuation token")
+
+// RangeRequest selects a single page of a range query. To is inclusive and defaults to the end of history.
+// When Continuation is set, the page starts where the previous page ended and From is ignored.
+type RangeRequest struct {
+	From         hexutil.Uint64  `json:"from"`
+	To           *hexutil.Uint64 `json:"to"`
+	Limit        hexutil.Uint64  `json:"limit"`
+	Continuation string          `json:"continuation"`
+}
+
+// SlotInfosPage is a page of verified or invalid slot infos. Continuation is empty on the last page.
+type SlotInfosPage struct {
+	SlotInfos    []*VerifiedSlotInfo `json:"slotInfos"`
+	Continuation string              `json:"continuation,omitempty"`
+}
+
+// ConsensusInfosPage is a page of consensus infos. Continuation is empty on the last page.
+type ConsensusInfosPage struct {
+	ConsensusInfos []*generalTypes.MinimalEpochConsensusInfo `json:"consensusInfos"`
+	Continuation   string                                    `json:"continuation,omitempty"`
+}
+
+// GetConsensusInfos returns a page of consensus infos in ascending epoch order
+func (api *PublicFilterAPI) GetConsensusInfos(ctx context.Context, request RangeRequest) (*ConsensusInfosPage, error) {
+	from, to, limit, err := request.bounds()
+	if err != nil {
+		return nil, err
+	}
+	// one extra entry is fetched to find out where the next page starts
+	consensusInfos, err := api.backend.ConsensusInfoRange(from, to, limit+1)
+	if err != nil {
+		log.WithError(err).WithField("from", from).WithField("to", to).Error("Failed to retrieve consensus info range")
+		return nil, err
+	}
+	page := &ConsensusInfosPage{ConsensusInfos: consensusInfos}
+	if len(consensusInfos) > limit {
+		page.ConsensusInfos = consensusInfos[:limit]
+		page.Continuation = encodeContinuation(consensusInfos[limit].Epoch)
+	}
+	return page, nil
+}
+
+// GetVerifiedSlotInfos returns a page of verified slot infos in ascending slot order
+func (api *PublicFilterAPI) GetVerifiedSlotInfos(ctx context.Context, request RangeRequest) (*SlotInfosPage, error) {
+	return slotInfosPage(request, api.backend.VerifiedSlotInfoRange)
+}
+
+// GetInvalidSlotInfos returns a page of invalid slot infos in ascending slot order
+func (api *PublicFilterAPI) GetInvalidSlotInfos(ctx context.Context, request RangeRequest) (*SlotInfosPage, error) {
+	return slotInfosPage(request, api.backend.InvalidSlotInfoRange)
+}
+
+// slotInfosPage
+func slotInfosPage(
+	request RangeRequest,
+	rangeFn func(fromSlot, toSlot uint64, limit int) ([]*generalTypes.SlotInfoWithSlot, error),
+) (*SlotInfosPage, error) {
+	from, to, limit, err := request.bounds()
+	if err != nil {
+		return nil, err
+	}
+	// one extra entry is fetched to find out where the next page starts
+	slotInfos, err := rangeFn(from, to, limit+1)
+	if err != nil {
+		log.WithError(err).WithField("from", from).WithField("to", to).Error("Failed to retrieve slot info range")
+		return nil, err
+	}
+	page := &SlotInfosPage{SlotInfos: make([]*VerifiedSlotInfo, 0, len(slotInfos))}
+	for i, slotInfo := range slotInfos {
+		if i == limit {
+			page.Continuation = encodeContinuation(slotInfo.Slot)
+			break
+		}
+		page.SlotInfos = append(page.SlotInfos, newVerifiedSlotInfo(slotInfo.Slot, &slotInfo.SlotInfo))
+	}
+	return page, nil
+}
+
+// bounds returns the inclusive range and the page size of the request
+func (request *RangeRequest) bounds() (from, to uint64, limit int, err error) {
+	from, to, limit = uint64(request.From), math.MaxUint64, defaultPageSize
+	if request.Continuation != "" {
+		if from, err = decodeContinuation(request.Continuation); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	if request.To != nil {
+		to = uint64(*request.To)
+	}
+	// limit is capped before the conversion so that a huge limit can not overflow into a negative one
+	if request.Limit > maxPageSize {
+		limit = maxPageSize
+	} else if request.Limit > 0 {
+		limit = int(request.Limit)
+	}
+	return from, to, limit, nil
+}
+
+// encodeContinuation creates the token which points to the first entry of the next page
+func encodeContinuation(next uint64) string {
+	return hexutil.EncodeUint64(next)
+}
+
+// decodeContinuation
+func decodeContinuation(token string) (uint64, error) {
+	next, err := hexutil.DecodeUint64(token)
+	if err != nil {
+		return 0, errors.Wrapf(errInvalidContinuation, "continuation: %s", token)
+	}
+	return next, nil
+}
@@ -0,0 +1,107 @@
+package events
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+func TestPublicFilterAPI_GetVerifiedSlotInfos_Paging(t *testing.T) {
+	backend, eventApi := setup(t)
+	ctx := context.Background()
+	backend.verifiedSlotInfos = make(map[uint64]*eventTypes.SlotInfo)
+	for slot := uint64(1); slot <= 25; slot++ {
+		backend.verifiedSlotInfos[slot] = &eventTypes.SlotInfo{
+			VanguardBlockHash: common.BytesToHash([]byte{1, byte(slot)}),
+			PandoraHeaderHash: common.BytesToHash([]byte{2, byte(slot)}),
+		}
+	}
+
+	slots := make([]uint64, 0)
+	request := RangeRequest{From: 3, Limit: 10}
+	pages := 0
+	for {
+		page, err := eventApi.GetVerifiedSlotInfos(ctx, request)
+		require.NoError(t, err)
+		pages++
+		for _, slotInfo := range page.SlotInfos {
+			slots = append(slots, uint64(slotInfo.Slot))
+		}
+		if page.Continuation == "" {
+			break
+		}
+		request.Continuation = page.Continuation
+	}
+	assert.Equal(t, 3, pages)
+	assert.Equal(t, 23, len(slots))
+	for i, slot := range slots {
+		assert.Equal(t, uint64(i+3), slot)
+	}
+
+	to := hexutil.Uint64(5)
+	page, err := eventApi.GetVerifiedSlotInfos(ctx, RangeRequest{From: 3, To: &to})
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(page.SlotInfos))
+	assert.Equal(t, "", page.Continuation)
+
+	_, err = eventApi.GetVerifiedSlotInfos(ctx, RangeRequest{Continuation: "not-a-token"})
+	assert.ErrorContains(t, errInvalidContinuation.Error(), err)
+}
+
+func TestPublicFilterAPI_GetInvalidSlotInfos(t *testing.T) {
+	backend, eventApi := setup(t)
+	slotInfo := &eventTypes.SlotInfo{PandoraHeaderHash: common.BytesToHash([]byte{2, 9})}
+	backend.invalidSlotInfos = map[uint64]*eventTypes.SlotInfo{9: slotInfo}
+
+	page, err := eventApi.GetInvalidSlotInfos(context.Background(), RangeRequest{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*VerifiedSlotInfo{newVerifiedSlotInfo(9, slotInfo)}, page.SlotInfos)
+}
+
+func TestPublicFilterAPI_GetConsensusInfos(t *testing.T) {
+	_, eventApi := setup(t)
+	ctx := context.Background()
+
+	page, err := eventApi.GetConsensusInfos(ctx, RangeRequest{From: 1, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(page.ConsensusInfos))
+	assert.Equal(t, uint64(1), page.ConsensusInfos[0].Epoch)
+	assert.Equal(t, hexutil.EncodeUint64(3), page.Continuation)
+
+	page, err = eventApi.GetConsensusInfos(ctx, RangeRequest{Continuation: page.Continuation, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(page.ConsensusInfos))
+	assert.Equal(t, uint64(4), page.ConsensusInfos[1].Epoch)
+	assert.Equal(t, "", page.Continuation)
+}
+
+func TestPublicFilterAPI_RangeLimitIsCapped(t *testing.T) {
+	backend, eventApi := setup(t)
+	ctx := context.Background()
+	backend.verifiedSlotInfos = make(map[uint64]*eventTypes.SlotInfo)
+	for slot := uint64(1); slot <= maxPageSize+10; slot++ {
+		backend.verifiedSlotInfos[slot] = &eventTypes.SlotInfo{}
+	}
+
+	for _, limit := range []hexutil.Uint64{maxPageSize + 1, math.MaxInt64 + 1, math.MaxUint64} {
+		_, _, actualLimit, err := (&RangeRequest{Limit: limit}).bounds()
+		require.NoError(t, err)
+		assert.Equal(t, maxPageSize, actualLimit)
+	}
+
+	page, err := eventApi.GetVerifiedSlotInfos(ctx, RangeRequest{From: 1, Limit: math.MaxUint64})
+	require.NoError(t, err)
+	assert.Equal(t, maxPageSize, len(page.SlotInfos))
+	assert.Equal(t, hexutil.EncodeUint64(maxPageSize+1), page.Continuation)
+
+	consensusPage, err := eventApi.GetConsensusInfos(ctx, RangeRequest{Limit: math.MaxUint64})
+	require.NoError(t, err)
+	assert.Equal(t, 5, len(consensusPage.ConsensusInfos))
+	assert.Equal(t, "", consensusPage.Continuation)
+}
@@ -0,0 +1,62 @@
+package events
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	generalTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+// ReorgFilter selects journaled reorgs. FromTime is a unix timestamp in seconds. Reorgs are returned
+// when they happened at or after FromTime and affected slots at or after FromSlot.
+type ReorgFilter struct {
+	FromTime hexutil.Uint64 `json:"fromTime"`
+	FromSlot hexutil.Uint64 `json:"fromSlot"`
+}
+
+// GetReorgs returns journaled reorgs which match the given filter
+func (api *PublicFilterAPI) GetReorgs(ctx context.Context, filter ReorgFilter) ([]*generalTypes.ReorgRecord, error) {
+	records, err := api.backend.ReorgRecords(uint64(filter.FromTime), uint64(filter.FromSlot))
+	if err != nil {
+		log.WithError(err).WithField("fromTime", filter.FromTime).WithField("fromSlot", filter.FromSlot).
+			Error("Failed to retrieve reorg records")
+		return nil, err
+	}
+	return records, nil
+}
+
+// Reorgs sends every new journaled reorg to the subscriber
+func (api *PublicFilterAPI) Reorgs(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		reorgCh := make(chan *generalTypes.ReorgRecord)
+		reorgSub := api.events.SubscribeReorg(reorgCh)
+
+		for {
+			select {
+			case record := <-reorgCh:
+				if err := notifier.Notify(rpcSub.ID, record); err != nil {
+					log.WithField("epoch", record.Epoch).WithError(err).Error("Failed to notify reorg record")
+					reorgSub.Unsubscribe()
+					return
+				}
+			case <-rpcSub.Err():
+				log.Info("Unsubscribing registered subscriber from Reorgs")
+				reorgSub.Unsubscribe()
+				return
+			case <-notifier.Closed():
+				log.Info("Closing notifier. Unsubscribing registered subscriber from Reorgs")
+				reorgSub.Unsubscribe()
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
@@ -0,0 +1,51 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+func TestPublicFilterAPI_GetReorgs(t *testing.T) {
+	backend, eventApi := setup(t)
+	first := &eventTypes.ReorgRecord{Epoch: 2, ReorgInfo: &eventTypes.Reorg{NewSlot: 70}, Timestamp: 100}
+	second := &eventTypes.ReorgRecord{Epoch: 4, ReorgInfo: &eventTypes.Reorg{NewSlot: 130}, ToSlot: 140, Timestamp: 200}
+	backend.Reorgs = []*eventTypes.ReorgRecord{first, second}
+
+	records, err := eventApi.GetReorgs(context.Background(), ReorgFilter{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*eventTypes.ReorgRecord{first, second}, records)
+
+	records, err = eventApi.GetReorgs(context.Background(), ReorgFilter{FromTime: hexutil.Uint64(150)})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*eventTypes.ReorgRecord{second}, records)
+
+	records, err = eventApi.GetReorgs(context.Background(), ReorgFilter{FromSlot: hexutil.Uint64(141)})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+}
+
+func TestEventSystem_SubscribeReorg(t *testing.T) {
+	backend, eventApi := setup(t)
+	reorgCh := make(chan *eventTypes.ReorgRecord)
+	subscriber := eventApi.events.SubscribeReorg(reorgCh)
+	expected := &eventTypes.ReorgRecord{Epoch: 3, ReorgInfo: &eventTypes.Reorg{NewSlot: 100}}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		backend.ReorgFeed.Send(expected)
+	}()
+
+	select {
+	case record := <-reorgCh:
+		assert.DeepEqual(t, expected, record)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for reorg record")
+	}
+	subscriber.Unsubscribe()
+}
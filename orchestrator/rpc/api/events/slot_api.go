@@ -0,0 +1,60 @@
+package events
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	generalTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+var errVerifiedSlotInfoNotFound = errors.New("verified slot info not found")
+
+// VerifiedSlotInfo is the json representation of a verified slot info with its slot number
+type VerifiedSlotInfo struct {
+	Slot              hexutil.Uint64 `json:"slot"`
+	VanguardBlockHash common.Hash    `json:"vanguardBlockHash"`
+	PandoraHeaderHash common.Hash    `json:"pandoraHeaderHash"`
+}
+
+// newVerifiedSlotInfo
+func newVerifiedSlotInfo(slot uint64, slotInfo *generalTypes.SlotInfo) *VerifiedSlotInfo {
+	return &VerifiedSlotInfo{
+		Slot:              hexutil.Uint64(slot),
+		VanguardBlockHash: slotInfo.VanguardBlockHash,
+		PandoraHeaderHash: slotInfo.PandoraHeaderHash,
+	}
+}
+
+// GetVerifiedSlotByPandoraHeaderHash returns the verified slot info which holds the given pandora header hash
+func (api *PublicFilterAPI) GetVerifiedSlotByPandoraHeaderHash(
+	ctx context.Context,
+	hash common.Hash,
+) (*VerifiedSlotInfo, error) {
+	slot, slotInfo, err := api.backend.VerifiedSlotInfoByPandoraHeaderHash(hash)
+	if err != nil {
+		log.WithError(err).WithField("hash", hash).Error("Failed to retrieve verified slot by pandora header hash")
+		return nil, err
+	}
+	if slotInfo == nil {
+		return nil, errors.Wrapf(errVerifiedSlotInfoNotFound, "pandoraHeaderHash: %s", hash.Hex())
+	}
+	return newVerifiedSlotInfo(slot, slotInfo), nil
+}
+
+// GetVerifiedSlotByVanguardBlockHash returns the verified slot info which holds the given vanguard block hash
+func (api *PublicFilterAPI) GetVerifiedSlotByVanguardBlockHash(
+	ctx context.Context,
+	hash common.Hash,
+) (*VerifiedSlotInfo, error) {
+	slot, slotInfo, err := api.backend.VerifiedSlotInfoByVanguardBlockHash(hash)
+	if err != nil {
+		log.WithError(err).WithField("hash", hash).Error("Failed to retrieve verified slot by vanguard block hash")
+		return nil, err
+	}
+	if slotInfo == nil {
+		return nil, errors.Wrapf(errVerifiedSlotInfoNotFound, "vanguardBlockHash: %s", hash.Hex())
+	}
+	return newVerifiedSlotInfo(slot, slotInfo), nil
+}
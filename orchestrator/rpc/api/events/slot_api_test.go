@@ -0,0 +1,41 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+func TestPublicFilterAPI_GetVerifiedSlotByHash(t *testing.T) {
+	backend, eventApi := setup(t)
+	ctx := context.Background()
+	slotInfo := &eventTypes.SlotInfo{
+		VanguardBlockHash: common.HexToHash("0x6f701e4e8b260f38a43cdc0d97cfdc7f0cd33f58ef26bbc6c327ac87d76304d2"),
+		PandoraHeaderHash: common.HexToHash("0x0846da512db0a6888a59aa5f7235b741e36a9dcacc9dad33ee2a228878aefa74"),
+	}
+	backend.verifiedSlotInfos = map[uint64]*eventTypes.SlotInfo{12: slotInfo}
+	expected := &VerifiedSlotInfo{
+		Slot:              hexutil.Uint64(12),
+		VanguardBlockHash: slotInfo.VanguardBlockHash,
+		PandoraHeaderHash: slotInfo.PandoraHeaderHash,
+	}
+
+	actual, err := eventApi.GetVerifiedSlotByPandoraHeaderHash(ctx, slotInfo.PandoraHeaderHash)
+	require.NoError(t, err)
+	assert.DeepEqual(t, expected, actual)
+
+	actual, err = eventApi.GetVerifiedSlotByVanguardBlockHash(ctx, slotInfo.VanguardBlockHash)
+	require.NoError(t, err)
+	assert.DeepEqual(t, expected, actual)
+
+	_, err = eventApi.GetVerifiedSlotByPandoraHeaderHash(ctx, slotInfo.VanguardBlockHash)
+	assert.ErrorContains(t, errVerifiedSlotInfoNotFound.Error(), err)
+
+	_, err = eventApi.GetVerifiedSlotByVanguardBlockHash(ctx, slotInfo.PandoraHeaderHash)
+	assert.ErrorContains(t, errVerifiedSlotInfoNotFound.Error(), err)
+}
@@ -0,0 +1,59 @@
+package events
+
+import (
+	"context"
+
+	generalTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	errSyncStatusUnavailable        = errors.New("vanguard sync status is not available yet")
+	errPandoraSyncStatusUnavailable = errors.New("pandora sync status is not available yet")
+)
+
+// GetVanguardSyncStatus returns how far orchestrator's latest received and verified slots lag behind
+// vanguard's canonical head. Pandora should not trust orchestrator while it is syncing.
+func (api *PublicFilterAPI) GetVanguardSyncStatus(ctx context.Context) (*generalTypes.VanguardSyncStatus, error) {
+	syncStatus := api.backend.VanguardSyncStatus()
+	if syncStatus == nil {
+		return nil, errSyncStatusUnavailable
+	}
+	return syncStatus, nil
+}
+
+// GetVanguardBackfillProgress returns the progress of the latest backfill of vanguard blocks which have been
+// missed while orchestrator was offline. It is null when no backfill has been needed so far.
+func (api *PublicFilterAPI) GetVanguardBackfillProgress(ctx context.Context) (*generalTypes.BackfillProgress, error) {
+	return api.backend.VanguardBackfillProgress(), nil
+}
+
+// GetPandoraSyncStatus returns whether pandora is synced, syncing or orchestrator is lagging behind its head,
+// together with the distances of orchestrator's latest received and verified blocks from pandora's head.
+func (api *PublicFilterAPI) GetPandoraSyncStatus(ctx context.Context) (*generalTypes.PandoraSyncStatus, error) {
+	syncStatus := api.backend.PandoraSyncStatus()
+	if syncStatus == nil {
+		return nil, errPandoraSyncStatusUnavailable
+	}
+	return syncStatus, nil
+}
+
+// GetConsensusInfoViolations returns the latest consensus infos from vanguard which have been rejected
+// by validation and therefore have not been stored, oldest first
+func (api *PublicFilterAPI) GetConsensusInfoViolations(ctx context.Context) ([]*generalTypes.ConsensusInfoViolation, error) {
+	violations := api.backend.ConsensusInfoViolations()
+	if violations == nil {
+		violations = make([]*generalTypes.ConsensusInfoViolation, 0)
+	}
+	return violations, nil
+}
+
+// GetRejectedPandoraHeaders returns the latest pandora headers which have been rejected by validation
+// on arrival and therefore have not been processed, oldest first
+func (api *PublicFilterAPI) GetRejectedPandoraHeaders(ctx context.Context) ([]*generalTypes.RejectedPandoraHeader, error) {
+	rejectedHeaders := api.backend.RejectedPandoraHeaders()
+	if rejectedHeaders == nil {
+		rejectedHeaders = make([]*generalTypes.RejectedPandoraHeader, 0)
+	}
+	return rejectedHeaders, nil
+}
@@ -0,0 +1,78 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+func TestPublicFilterAPI_GetVanguardSyncStatus(t *testing.T) {
+	backend, eventApi := setup(t)
+	_, err := eventApi.GetVanguardSyncStatus(context.Background())
+	assert.ErrorContains(t, errSyncStatusUnavailable.Error(), err)
+
+	backend.SyncStatus = eventTypes.NewVanguardSyncStatus(100, 60, 50, 32)
+	syncStatus, err := eventApi.GetVanguardSyncStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, true, syncStatus.Syncing)
+	assert.Equal(t, uint64(40), syncStatus.ReceivedDistance)
+	assert.Equal(t, uint64(50), syncStatus.VerifiedDistance)
+}
+
+func TestPublicFilterAPI_GetVanguardBackfillProgress(t *testing.T) {
+	backend, eventApi := setup(t)
+	progress, err := eventApi.GetVanguardBackfillProgress(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, true, progress == nil)
+
+	backend.Backfill = &eventTypes.BackfillProgress{FromSlot: 10, ToSlot: 100, CurrentSlot: 40, Processed: 30, Running: true}
+	progress, err = eventApi.GetVanguardBackfillProgress(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, backend.Backfill, progress)
+}
+
+func TestPublicFilterAPI_GetConsensusInfoViolations(t *testing.T) {
+	backend, eventApi := setup(t)
+	violations, err := eventApi.GetConsensusInfoViolations(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(violations))
+
+	backend.Violations = []*eventTypes.ConsensusInfoViolation{{Epoch: 5, Reason: "invalid", Timestamp: 100}}
+	violations, err = eventApi.GetConsensusInfoViolations(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, backend.Violations, violations)
+}
+
+func TestPublicFilterAPI_GetRejectedPandoraHeaders(t *testing.T) {
+	backend, eventApi := setup(t)
+	rejectedHeaders, err := eventApi.GetRejectedPandoraHeaders(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(rejectedHeaders))
+
+	backend.RejectedHeaders = []*eventTypes.RejectedPandoraHeader{{BlockNumber: 5, Slot: 6, Reason: "invalid", Timestamp: 100}}
+	rejectedHeaders, err = eventApi.GetRejectedPandoraHeaders(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, backend.RejectedHeaders, rejectedHeaders)
+}
+
+func TestPublicFilterAPI_GetPandoraSyncStatus(t *testing.T) {
+	backend, eventApi := setup(t)
+	_, err := eventApi.GetPandoraSyncStatus(context.Background())
+	assert.ErrorContains(t, errPandoraSyncStatusUnavailable.Error(), err)
+
+	backend.PanSyncStatus = eventTypes.NewPandoraSyncStatus(100, 0, 90, 50, 32)
+	syncStatus, err := eventApi.GetPandoraSyncStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, eventTypes.PandoraLagging, syncStatus.State)
+	assert.Equal(t, uint64(10), syncStatus.ReceivedDistance)
+	assert.Equal(t, uint64(50), syncStatus.VerifiedDistance)
+
+	backend.PanSyncStatus = eventTypes.NewPandoraSyncStatus(100, 200, 100, 100, 32)
+	syncStatus, err = eventApi.GetPandoraSyncStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, eventTypes.PandoraSyncing, syncStatus.State)
+	assert.Equal(t, uint64(200), syncStatus.HighestBlockNumber)
+}
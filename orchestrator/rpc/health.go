@@ -0,0 +1,128 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/connection"
+	"github.com/pkg/errors"
+)
+
+const (
+	healthPath = "/health"
+	readyPath  = "/ready"
+)
+
+var (
+	errConsensusLoopStopped  = errors.New("consensus loop is not running")
+	errServiceNotRunning     = errors.New("service is not running")
+	errVanguardHeadUnknown   = errors.New("vanguard head slot is not known yet")
+	errVerifiedSlotIsLagging = errors.New("latest verified slot is lagging behind vanguard head")
+)
+
+// healthCheck is the result of a single check of /health or /ready. Error is empty when the check passed
+type healthCheck struct {
+	Name  string `json:"name"`
+	Ok    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// healthResponse is the json body of /health and /ready
+type healthResponse struct {
+	Ok     bool           `json:"ok"`
+	Checks []*healthCheck `json:"checks"`
+}
+
+// newHealthCheck
+func newHealthCheck(name string, err error) *healthCheck {
+	check := &healthCheck{Name: name, Ok: err == nil}
+	if err != nil {
+		check.Error = err.Error()
+	}
+	return check
+}
+
+// healthHandler serves the liveness of the node. It fails when the consensus loop has stopped
+// because the node cannot recover from it without a restart.
+func (s *Service) healthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeHealthResponse(w, r, []*healthCheck{
+			newHealthCheck("consensus", s.checkConsensusLoop()),
+		})
+	})
+}
+
+// readyHandler serves the readiness of the node. The node is ready when vanguard and pandora are subscribed,
+// the consensus loop is running and the latest verified slot is close enough to the vanguard head.
+func (s *Service) readyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeHealthResponse(w, r, []*healthCheck{
+			newHealthCheck("vanguard", checkConnection(s.backend.VanguardConnectionStats())),
+			newHealthCheck("pandora", checkConnection(s.backend.PandoraConnectionStats())),
+			newHealthCheck("consensus", s.checkConsensusLoop()),
+			newHealthCheck("verifiedSlotLag", s.checkVerifiedSlotLag()),
+		})
+	})
+}
+
+// checkConsensusLoop
+func (s *Service) checkConsensusLoop() error {
+	if s.config.ConsensusLoopProvider == nil || !s.config.ConsensusLoopProvider.IsLoopRunning() {
+		return errConsensusLoopStopped
+	}
+	return nil
+}
+
+// checkVerifiedSlotLag
+func (s *Service) checkVerifiedSlotLag() error {
+	syncStatus := s.backend.VanguardSyncStatus()
+	if syncStatus == nil {
+		return errVanguardHeadUnknown
+	}
+	if syncStatus.VerifiedDistance > s.config.ReadyMaxSlotLag {
+		return errors.Wrapf(errVerifiedSlotIsLagging, "verified slot %d is %d slots behind head slot %d, max %d",
+			syncStatus.LatestVerifiedSlot, syncStatus.VerifiedDistance, syncStatus.HeadSlot, s.config.ReadyMaxSlotLag)
+	}
+	return nil
+}
+
+// checkConnection
+func checkConnection(stats *connection.Stats) error {
+	if stats == nil {
+		return errServiceNotRunning
+	}
+	if stats.State != connection.Subscribed {
+		if stats.LastError != "" {
+			return fmt.Errorf("connection is %s: %s", stats.State, stats.LastError)
+		}
+		return fmt.Errorf("connection is %s", stats.State)
+	}
+	return nil
+}
+
+// writeHealthResponse writes the checks as json. The status code is 503 when any check failed
+func writeHealthResponse(w http.ResponseWriter, r *http.Request, checks []*healthCheck) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	response := &healthResponse{Ok: true, Checks: checks}
+	for _, check := range checks {
+		response.Ok = response.Ok && check.Ok
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+	if response.Ok {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if r.Method == http.MethodHead {
+		return
+	}
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.WithError(err).Debug("Failed to write health response")
+	}
+}
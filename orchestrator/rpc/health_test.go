@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/connection"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+type mockHealthProvider struct {
+	state       connection.State
+	loopRunning bool
+	syncStatus  *types.VanguardSyncStatus
+}
+
+func (m *mockHealthProvider) ConnectionStats() connection.Stats {
+	return connection.Stats{State: m.state, LastError: "connection refused"}
+}
+
+func (m *mockHealthProvider) IsLoopRunning() bool {
+	return m.loopRunning
+}
+
+func (m *mockHealthProvider) SyncStatus() *types.VanguardSyncStatus {
+	return m.syncStatus
+}
+
+func healthRequest(t *testing.T, url string) (int, *healthResponse) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	// probes do not use the configured virtual hosts
+	req.Host = "10.0.0.1"
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	response := new(healthResponse)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(response))
+	return resp.StatusCode, response
+}
+
+// TestServerStart_HealthAndReady
+func TestServerStart_HealthAndReady(t *testing.T) {
+	config, err := setup(t)
+	require.NoError(t, err)
+	config.HTTPPort, config.WSPort = 0, 0
+	config.HTTPPathPrefix = "/rpc"
+	provider := &mockHealthProvider{state: connection.Connecting}
+	config.VanguardConnection = provider
+	config.PandoraConnection = provider
+	config.ConsensusLoopProvider = provider
+	config.SyncStatusProvider = provider
+	config.ReadyMaxSlotLag = 32
+
+	rpcService, err := NewService(context.Background(), config)
+	require.NoError(t, err)
+	require.NoError(t, rpcService.startRPC())
+	defer func() {
+		assert.NoError(t, rpcService.Stop())
+	}()
+	url := "http://" + rpcService.http.listenAddr()
+
+	status, response := healthRequest(t, url+healthPath)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.DeepEqual(t, &healthResponse{Checks: []*healthCheck{
+		{Name: "consensus", Error: errConsensusLoopStopped.Error()},
+	}}, response)
+
+	status, response = healthRequest(t, url+readyPath)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.DeepEqual(t, &healthResponse{Checks: []*healthCheck{
+		{Name: "vanguard", Error: "connection is connecting: connection refused"},
+		{Name: "pandora", Error: "connection is connecting: connection refused"},
+		{Name: "consensus", Error: errConsensusLoopStopped.Error()},
+		{Name: "verifiedSlotLag", Error: errVanguardHeadUnknown.Error()},
+	}}, response)
+
+	provider.state = connection.Subscribed
+	provider.loopRunning = true
+	provider.syncStatus = types.NewVanguardSyncStatus(100, 100, 60, 32)
+	status, response = healthRequest(t, url+healthPath)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, true, response.Ok)
+
+	status, response = healthRequest(t, url+readyPath)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Equal(t, false, response.Ok)
+	assert.Equal(t, "verifiedSlotLag", response.Checks[3].Name)
+	assert.ErrorContains(t, errVerifiedSlotIsLagging.Error(), errors.New(response.Checks[3].Error))
+
+	provider.syncStatus = types.NewVanguardSyncStatus(100, 100, 68, 32)
+	status, response = healthRequest(t, url+readyPath)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, true, response.Ok)
+
+	resp, err := http.Post(url+readyPath, "application/json", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
@@ -273,6 +273,19 @@ func (h *httpServer) enableRPC(apis []rpc.API, config httpConfig) error {
 	return nil
 }
 
+// registerHandler mounts a plain http handler on the given path. Mounted handlers are served next to
+// JSON-RPC over HTTP and they are not subject to the virtual host and cors checks.
+func (h *httpServer) registerHandler(name, path string, handler http.Handler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, exists := h.handlerNames[path]; exists {
+		return
+	}
+	h.mux.Handle(path, handler)
+	h.handlerNames[path] = name
+}
+
 // disableRPC stops the HTTP RPC handler. This is internal, the caller must hold h.mu.
 func (h *httpServer) disableRPC() bool {
 	handler := h.httpHandler.Load().(*rpcHandler)
@@ -500,7 +513,7 @@ func (is *ipcServer) stop() error {
 // and then registers all of the APIs exposed by the services.
 func RegisterApisFromWhitelist(apis []rpc.API, modules []string, srv *rpc.Server, exposeAll bool) error {
 	if bad, available := checkModuleAvailability(modules, apis); len(bad) > 0 {
-		log.Error("Unavailable modules in HTTP API list", "unavailable", bad, "available", available)
+		log.WithField("unavailable", bad).WithField("available", available).Error("Unavailable modules in API list")
 	}
 	// Generate the whitelist based on the allowed modules
 	whitelist := make(map[string]bool)
@@ -6,9 +6,12 @@ import (
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/cache"
 	conIface "github.com/lukso-network/lukso-orchestrator/orchestrator/consensus/iface"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
+	panIface "github.com/lukso-network/lukso-orchestrator/orchestrator/pandorachain/iface"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/rpc/api"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/rpc/api/admin"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/rpc/api/events"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/vanguardchain/iface"
+	"github.com/lukso-network/lukso-orchestrator/shared/connection"
 	"sync"
 	"time"
 )
@@ -17,9 +20,23 @@ import (
 type Config struct {
 	ConsensusInfoFeed            iface.ConsensusInfoFeed
 	VerifiedSlotInfoFeed         conIface.VerifiedSlotInfoFeed
+	ReorgFeed                    iface.ReorgFeed
+	SyncStatusProvider           iface.SyncStatusProvider
+	BackfillProgressProvider     iface.BackfillProgressProvider
+	ViolationProvider            iface.ConsensusInfoViolationProvider
+	PandoraSyncStatusProvider    panIface.SyncStatusProvider
+	RejectedHeaderProvider       panIface.RejectedHeaderProvider
 	Db                           db.Database
 	VanguardPendingShardingCache cache.VanguardShardCache
 	PandoraPendingHeaderCache    cache.PandoraHeaderCache
+	// admin config
+	ServiceStatusProvider api.ServiceStatusProvider
+	VanguardConnection    connection.StatsProvider
+	VanguardEndpoint      iface.EndpointProvider
+	PandoraConnection     connection.StatsProvider
+	// health config
+	ConsensusLoopProvider conIface.LoopStatusProvider
+	ReadyMaxSlotLag       uint64
 	// ipc config
 	IPCPath string
 	// http config
@@ -37,6 +54,7 @@ type Config struct {
 	WSPort       int
 	WSPathPrefix string
 	WSOrigins    []string
+	WSModules    []string
 }
 
 // Service defining an RPC server for a orchestrator node.
@@ -73,14 +91,32 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 			ConsensusInfoDB:              cfg.Db,
 			VerifiedSlotInfoDB:           cfg.Db,
 			InvalidSlotInfoDB:            cfg.Db,
+			ReorgJournalDB:               cfg.Db,
 			PandoraPendingHeaderCache:    cfg.PandoraPendingHeaderCache,
 			VanguardPendingShardingCache: cfg.VanguardPendingShardingCache,
 			VerifiedSlotInfoFeed:         cfg.VerifiedSlotInfoFeed,
+			ReorgFeed:                    cfg.ReorgFeed,
+			SyncStatusProvider:           cfg.SyncStatusProvider,
+			BackfillProgressProvider:     cfg.BackfillProgressProvider,
+			ViolationProvider:            cfg.ViolationProvider,
+			PandoraSyncStatusProvider:    cfg.PandoraSyncStatusProvider,
+			RejectedHeaderProvider:       cfg.RejectedHeaderProvider,
+			ServiceStatusProvider:        cfg.ServiceStatusProvider,
+			VanguardConnection:           cfg.VanguardConnection,
+			VanguardEndpoint:             cfg.VanguardEndpoint,
+			PandoraConnection:            cfg.PandoraConnection,
 		},
 	}
+	if cfg.Db != nil {
+		service.backend.DBPath = cfg.Db.DatabasePath()
+	}
 	// Configure RPC servers.
 	service.rpcAPIs = service.APIs()
-	service.http = newHTTPServer(rpc.DefaultHTTPTimeouts)
+	httpTimeouts := cfg.HTTPTimeouts
+	if httpTimeouts == (rpc.HTTPTimeouts{}) {
+		httpTimeouts = rpc.DefaultHTTPTimeouts
+	}
+	service.http = newHTTPServer(httpTimeouts)
 	service.ws = newHTTPServer(rpc.DefaultHTTPTimeouts)
 	service.ipc = newIPCServer(service.config.IPCPath)
 
@@ -145,11 +181,14 @@ func (s *Service) startRPC() error {
 
 	// Configure HTTP.
 	if s.config.HTTPEnable && s.config.HTTPHost != "" {
+		if err := validatePrefix("HTTP", s.config.HTTPPathPrefix); err != nil {
+			return err
+		}
 		config := httpConfig{
-			CorsAllowedOrigins: nil,
-			Vhosts:             nil,
-			Modules:            nil,
-			prefix:             "",
+			CorsAllowedOrigins: s.config.HTTPCors,
+			Vhosts:             s.config.HTTPVirtualHosts,
+			Modules:            s.config.HTTPModules,
+			prefix:             s.config.HTTPPathPrefix,
 		}
 		if err := s.http.setListenAddr(s.config.HTTPHost, s.config.HTTPPort); err != nil {
 			return err
@@ -157,15 +196,20 @@ func (s *Service) startRPC() error {
 		if err := s.http.enableRPC(s.rpcAPIs, config); err != nil {
 			return err
 		}
+		s.http.registerHandler("health", healthPath, s.healthHandler())
+		s.http.registerHandler("ready", readyPath, s.readyHandler())
 	}
 
 	// Configure WebSocket.
 	if s.config.WSEnable && s.config.WSHost != "" {
+		if err := validatePrefix("WebSocket", s.config.WSPathPrefix); err != nil {
+			return err
+		}
 		server := s.wsServerForPort(s.config.WSPort)
 		config := wsConfig{
-			Modules: nil,
-			Origins: []string{"*"},
-			prefix:  "",
+			Modules: s.config.WSModules,
+			Origins: s.config.WSOrigins,
+			prefix:  s.config.WSPathPrefix,
 		}
 		if err := server.setListenAddr(s.config.WSHost, s.config.WSPort); err != nil {
 			return err
@@ -220,6 +264,8 @@ func (s *Service) Wait() {
 	<-s.stop
 }
 
+// APIs returns the orc namespace and the admin namespace. The admin namespace is not public so it is
+// only served over http and websocket when it is listed in the enabled modules.
 func (s *Service) APIs() []rpc.API {
 	// Append all the local APIs and return
 	return []rpc.API{
@@ -229,5 +275,11 @@ func (s *Service) APIs() []rpc.API {
 			Service:   events.NewPublicFilterAPI(s.backend, 5*time.Minute),
 			Public:    true,
 		},
+		{
+			Namespace: "admin",
+			Version:   "1.0",
+			Service:   admin.NewPrivateAdminAPI(s.backend),
+			Public:    false,
+		},
 	}
 }
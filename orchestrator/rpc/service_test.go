@@ -2,6 +2,8 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/cache"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/consensus"
 	testDB "github.com/lukso-network/lukso-orchestrator/orchestrator/db/testing"
@@ -10,6 +12,7 @@ import (
 	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
 	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"net/http"
 	"testing"
 )
 
@@ -17,7 +20,7 @@ func setup(t *testing.T) (*Config, error) {
 	orchestratorDB := testDB.SetupDB(t)
 	consensusInfoFeed, err := vanguardchain.NewService(
 		context.Background(),
-		cmd.DefaultVanguardGRPCEndpoint,
+		[]string{cmd.DefaultVanguardGRPCEndpoint},
 		orchestratorDB,
 		cache.NewVanShardInfoCache(1<<10),
 		vanguardchain.GRPCFunc,
@@ -40,6 +43,7 @@ func setup(t *testing.T) (*Config, error) {
 	return &Config{
 		ConsensusInfoFeed:    consensusInfoFeed,
 		VerifiedSlotInfoFeed: consensusSvr,
+		ReorgFeed:            consensusInfoFeed,
 		Db:                   orchestratorDB,
 		IPCPath:              cmd.DefaultIpcPath,
 		HTTPEnable:           true,
@@ -73,3 +77,103 @@ func TestServerStart_Success(t *testing.T) {
 	hook.Reset()
 	assert.NoError(t, rpcService.Stop())
 }
+
+// TestServerStart_Config makes sure that cors, virtual hosts, modules, origins and path prefixes are applied
+func TestServerStart_Config(t *testing.T) {
+	ctx := context.Background()
+	config, err := setup(t)
+	require.NoError(t, err)
+	// http and websocket share the server on the same random port
+	config.HTTPPort, config.WSPort = 0, 0
+	config.HTTPCors = []string{"https://app.example"}
+	config.HTTPVirtualHosts = []string{"orchestrator.local"}
+	config.HTTPModules = []string{rpc.MetadataApi}
+	config.HTTPPathPrefix = "/rpc"
+	config.WSOrigins = []string{"https://app.example"}
+	config.WSPathPrefix = "/ws"
+
+	rpcService, err := NewService(ctx, config)
+	require.NoError(t, err)
+	require.NoError(t, rpcService.startRPC())
+	defer func() {
+		assert.NoError(t, rpcService.Stop())
+	}()
+	addr := rpcService.http.listenAddr()
+
+	// only whitelisted modules are served over http
+	resp := rpcRequest(t, "http://"+addr+"/rpc", "host", "orchestrator.local", "origin", "https://app.example")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "https://app.example", resp.Header.Get("Access-Control-Allow-Origin"))
+	var modules struct {
+		Result map[string]string `json:"result"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&modules))
+	_, ok := modules.Result["orc"]
+	assert.Equal(t, false, ok)
+
+	resp = rpcRequest(t, "http://"+addr+"/rpc", "host", "bad.example")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp = rpcRequest(t, "http://"+addr+"/", "host", "orchestrator.local")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// all public modules are served over websocket
+	assert.NoError(t, wsRequest(t, "ws://"+addr+"/ws", "https://app.example"))
+	assert.NotNil(t, wsRequest(t, "ws://"+addr+"/ws", "https://bad.example"))
+	client, err := rpc.DialWebsocket(ctx, "ws://"+addr+"/ws", "")
+	require.NoError(t, err)
+	defer client.Close()
+	wsModules, err := client.SupportedModules()
+	require.NoError(t, err)
+	_, ok = wsModules["orc"]
+	assert.Equal(t, true, ok)
+}
+
+// TestServerStart_InvalidPathPrefix
+func TestServerStart_InvalidPathPrefix(t *testing.T) {
+	config, err := setup(t)
+	require.NoError(t, err)
+	config.HTTPPort = 0
+	config.HTTPPathPrefix = "rpc"
+
+	rpcService, err := NewService(context.Background(), config)
+	require.NoError(t, err)
+	assert.ErrorContains(t, `does not contain leading "/"`, rpcService.startRPC())
+	assert.NoError(t, rpcService.Stop())
+}
+
+// TestServerStart_AdminModule makes sure that the admin namespace is only served over http when it is enabled
+func TestServerStart_AdminModule(t *testing.T) {
+	ctx := context.Background()
+	config, err := setup(t)
+	require.NoError(t, err)
+	config.HTTPPort, config.WSPort = 0, 0
+
+	rpcService, err := NewService(ctx, config)
+	require.NoError(t, err)
+	require.NoError(t, rpcService.startRPC())
+	client, err := rpc.DialHTTP("http://" + rpcService.http.listenAddr())
+	require.NoError(t, err)
+	modules, err := client.SupportedModules()
+	require.NoError(t, err)
+	_, ok := modules["admin"]
+	assert.Equal(t, false, ok)
+	client.Close()
+	assert.NoError(t, rpcService.Stop())
+
+	config.HTTPModules = []string{"orc", "admin"}
+	rpcService, err = NewService(ctx, config)
+	require.NoError(t, err)
+	require.NoError(t, rpcService.startRPC())
+	defer func() {
+		assert.NoError(t, rpcService.Stop())
+	}()
+	client, err = rpc.DialHTTP("http://" + rpcService.http.listenAddr())
+	require.NoError(t, err)
+	defer client.Close()
+
+	var database struct {
+		Path string `json:"path"`
+	}
+	require.NoError(t, client.Call(&database, "admin_database"))
+	assert.Equal(t, config.Db.DatabasePath(), database.Path)
+}
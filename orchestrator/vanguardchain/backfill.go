@@ -0,0 +1,88 @@
+package vanguardchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/vanguardchain/client"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+var (
+	errBackfillConsensusInfo = errors.New("Could not backfill missing consensus info")
+	errBackfillEpochSkipped  = errors.New("Vanguard skipped an epoch while backfilling")
+)
+
+// consensusInfoBackfillTimeout bounds a single backfill, so that a stalled vanguard stream can not
+// block the consensus info subscription
+var consensusInfoBackfillTimeout = time.Minute
+
+// toConsensusInfoV2 converts vanguard's minimal consensus info into orchestrator's consensus info
+func toConsensusInfoV2(vanMinimalConsensusInfo *eth.MinimalConsensusInfo) *types.MinimalEpochConsensusInfoV2 {
+	consensusInfo := &types.MinimalEpochConsensusInfoV2{
+		Epoch:            uint64(vanMinimalConsensusInfo.Epoch),
+		ValidatorList:    vanMinimalConsensusInfo.ValidatorList,
+		EpochStartTime:   vanMinimalConsensusInfo.EpochTimeStart,
+		SlotTimeDuration: time.Duration(vanMinimalConsensusInfo.SlotTimeDuration.Seconds),
+	}
+	// if re-org happens then we get this info not nil
+	if vanMinimalConsensusInfo.ReorgInfo != nil {
+		consensusInfo.ReorgInfo = &types.Reorg{
+			VanParentHash: vanMinimalConsensusInfo.ReorgInfo.VanParentHash,
+			PanParentHash: vanMinimalConsensusInfo.ReorgInfo.PanParentHash,
+			NewSlot:       uint64(vanMinimalConsensusInfo.ReorgInfo.NewSlot),
+		}
+	}
+	return consensusInfo
+}
+
+// backfillConsensusInfos requests the missing epochs [fromEpoch, toEpoch) from vanguard over a separate
+// stream. Every backfilled epoch is stored without moving latest epoch and published to the subscribers
+// so that pandora receives the epochs in order. The gap must be filled epoch by epoch, a stream which
+// skips an epoch fails the backfill.
+func (s *Service) backfillConsensusInfos(vanClient client.VanguardClient, fromEpoch, toEpoch uint64) error {
+	log.WithField("fromEpoch", fromEpoch).WithField("toEpoch", toEpoch).
+		Warn("Found gap in consensus infos, backfilling missing epochs")
+
+	ctx, cancel := context.WithTimeout(s.ctx, consensusInfoBackfillTimeout)
+	defer cancel()
+	stream, err := vanClient.StreamMinimalConsensusInfo(ctx, fromEpoch)
+	if err != nil {
+		return errors.Wrap(err, "failed to subscribe to stream of consensus info for backfill")
+	}
+
+	for nextEpoch := fromEpoch; nextEpoch < toEpoch; {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		vanMinimalConsensusInfo, err := stream.Recv()
+		if err != nil {
+			return errors.Wrap(err, "failed to receive consensus info for backfill")
+		}
+		if vanMinimalConsensusInfo == nil {
+			return errConsensusInfoNil
+		}
+		s.recorder.RecordConsensusInfo(vanMinimalConsensusInfo)
+
+		consensusInfo := toConsensusInfoV2(vanMinimalConsensusInfo)
+		if consensusInfo.Epoch < nextEpoch {
+			continue
+		}
+		if consensusInfo.Epoch > nextEpoch {
+			return errors.Wrapf(errBackfillEpochSkipped, "expected epoch %d, got %d", nextEpoch, consensusInfo.Epoch)
+		}
+		if err := s.validateConsensusInfo(consensusInfo); err != nil {
+			s.recordViolation(consensusInfo, err)
+			return errors.Wrap(err, "invalid backfilled consensus info")
+		}
+		if err := s.orchestratorDB.SaveBackfilledConsensusInfo(s.ctx, consensusInfo.ConvertToEpochInfo()); err != nil {
+			return errors.Wrap(err, "failed to save backfilled consensus info")
+		}
+		log.WithField("epoch", consensusInfo.Epoch).Info("Backfilled missing consensus info")
+		s.consensusInfoFeed.Send(consensusInfo)
+		nextEpoch = consensusInfo.Epoch + 1
+	}
+	return nil
+}
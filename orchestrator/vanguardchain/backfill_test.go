@@ -0,0 +1,156 @@
+package vanguardchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	duration "github.com/golang/protobuf/ptypes/duration"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+	eth2Types "github.com/prysmaticlabs/eth2-types"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+type backfillClientMock struct {
+	vanClientMock
+	consensusInfos []*eth.MinimalConsensusInfo
+	fromEpoch      uint64
+	// stall makes the stream block until its context is done once all consensus infos are sent
+	stall     bool
+	streamCtx context.Context
+}
+
+func (v *backfillClientMock) StreamMinimalConsensusInfo(
+	ctx context.Context,
+	epoch uint64,
+) (eth.BeaconChain_StreamMinimalConsensusInfoClient, error) {
+	v.fromEpoch = epoch
+	v.streamCtx = ctx
+	stream := &backfillStreamMock{ctx: ctx, stall: v.stall}
+	for _, consensusInfo := range v.consensusInfos {
+		if uint64(consensusInfo.Epoch) >= epoch {
+			stream.consensusInfos = append(stream.consensusInfos, consensusInfo)
+		}
+	}
+	return stream, nil
+}
+
+type backfillStreamMock struct {
+	streamConsensusInfoClient
+	consensusInfos []*eth.MinimalConsensusInfo
+	ctx            context.Context
+	stall          bool
+}
+
+func (s *backfillStreamMock) Recv() (*eth.MinimalConsensusInfo, error) {
+	if len(s.consensusInfos) == 0 {
+		if s.stall {
+			<-s.ctx.Done()
+			return nil, s.ctx.Err()
+		}
+		return nil, errConsensusInfoNil
+	}
+	consensusInfo := s.consensusInfos[0]
+	s.consensusInfos = s.consensusInfos[1:]
+	return consensusInfo, nil
+}
+
+func newVanConsensusInfo(epoch uint64) *eth.MinimalConsensusInfo {
+	consensusInfo := testutil.NewMinimalConsensusInfo(epoch)
+	return &eth.MinimalConsensusInfo{
+		Epoch:            eth2Types.Epoch(epoch),
+		ValidatorList:    consensusInfo.ValidatorList,
+		EpochTimeStart:   consensusInfo.EpochStartTime,
+		SlotTimeDuration: &duration.Duration{Seconds: 6},
+	}
+}
+
+// Test_BackfillConsensusInfos checks that missing epochs are stored and published without moving latest epoch
+func Test_BackfillConsensusInfos(t *testing.T) {
+	ctx := context.Background()
+	vanSvc, db := SetupVanguardSvc(ctx, t, GRPCFunc)
+	for i := uint64(0); i < 2; i++ {
+		require.NoError(t, db.SaveConsensusInfo(ctx, testutil.NewMinimalConsensusInfo(i).ConvertToEpochInfo()))
+		require.NoError(t, db.SaveLatestEpoch(ctx))
+	}
+
+	vanClient := &backfillClientMock{}
+	for i := uint64(0); i < 6; i++ {
+		vanClient.consensusInfos = append(vanClient.consensusInfos, newVanConsensusInfo(i))
+	}
+
+	consensusInfoCh := make(chan *eventTypes.MinimalEpochConsensusInfoV2, 10)
+	sub := vanSvc.SubscribeMinConsensusInfoEvent(consensusInfoCh)
+	defer sub.Unsubscribe()
+
+	require.NoError(t, vanSvc.backfillConsensusInfos(vanClient, 2, 5))
+	assert.Equal(t, uint64(2), vanClient.fromEpoch)
+	assert.Equal(t, uint64(1), db.GetLatestEpoch())
+	// backfill stream is closed as soon as the gap is filled
+	assert.ErrorContains(t, context.Canceled.Error(), vanClient.streamCtx.Err())
+
+	for i := uint64(2); i < 5; i++ {
+		consensusInfo := <-consensusInfoCh
+		assert.Equal(t, i, consensusInfo.Epoch)
+
+		storedInfo, err := db.ConsensusInfo(ctx, i)
+		require.NoError(t, err)
+		require.NotNil(t, storedInfo)
+		assert.Equal(t, i, storedInfo.Epoch)
+	}
+	assert.Equal(t, 0, len(consensusInfoCh))
+
+	storedInfo, err := db.ConsensusInfo(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, true, storedInfo == nil)
+}
+
+// Test_BackfillConsensusInfos_StreamError checks that backfill fails when vanguard stream ends before the gap is filled
+func Test_BackfillConsensusInfos_StreamError(t *testing.T) {
+	ctx := context.Background()
+	vanSvc, _ := SetupVanguardSvc(ctx, t, GRPCFunc)
+	vanClient := &backfillClientMock{consensusInfos: []*eth.MinimalConsensusInfo{newVanConsensusInfo(2)}}
+
+	err := vanSvc.backfillConsensusInfos(vanClient, 2, 5)
+	assert.ErrorContains(t, "failed to receive consensus info for backfill", err)
+}
+
+// Test_BackfillConsensusInfos_SkippedEpoch checks that backfill fails instead of skipping an epoch missing in vanguard stream
+func Test_BackfillConsensusInfos_SkippedEpoch(t *testing.T) {
+	ctx := context.Background()
+	vanSvc, db := SetupVanguardSvc(ctx, t, GRPCFunc)
+	vanClient := &backfillClientMock{consensusInfos: []*eth.MinimalConsensusInfo{
+		newVanConsensusInfo(2),
+		newVanConsensusInfo(4),
+	}}
+
+	err := vanSvc.backfillConsensusInfos(vanClient, 2, 5)
+	assert.ErrorContains(t, errBackfillEpochSkipped.Error(), err)
+
+	storedInfo, err := db.ConsensusInfo(ctx, 2)
+	require.NoError(t, err)
+	require.NotNil(t, storedInfo)
+	for _, epoch := range []uint64{3, 4} {
+		storedInfo, err = db.ConsensusInfo(ctx, epoch)
+		require.NoError(t, err)
+		assert.Equal(t, true, storedInfo == nil)
+	}
+}
+
+// Test_BackfillConsensusInfos_Timeout checks that backfill gives up when vanguard stream stalls
+func Test_BackfillConsensusInfos_Timeout(t *testing.T) {
+	defer func(timeout time.Duration) {
+		consensusInfoBackfillTimeout = timeout
+	}(consensusInfoBackfillTimeout)
+	consensusInfoBackfillTimeout = 100 * time.Millisecond
+
+	ctx := context.Background()
+	vanSvc, _ := SetupVanguardSvc(ctx, t, GRPCFunc)
+	vanClient := &backfillClientMock{consensusInfos: []*eth.MinimalConsensusInfo{newVanConsensusInfo(2)}, stall: true}
+
+	err := vanSvc.backfillConsensusInfos(vanClient, 2, 5)
+	assert.ErrorContains(t, context.DeadlineExceeded.Error(), err)
+}
@@ -0,0 +1,108 @@
+package vanguardchain
+
+import (
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/vanguardchain/client"
+	"github.com/lukso-network/lukso-orchestrator/shared/params"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+// pendingBlocksRewind is the number of slots which pending block subscription rewinds. Vanguard replays
+// them, so a gap up to this size does not need backfill.
+const pendingBlocksRewind = params.SlotsPerEpoch
+
+// backfillPendingBlocks fetches the vanguard blocks which have been missed since the latest verified slot.
+// Blocks are fetched epoch by epoch and fed through OnNewPendingVanguardBlock in slot order.
+func (s *Service) backfillPendingBlocks(vanClient client.VanguardClient) error {
+	headSlot, err := vanClient.CanonicalHeadSlot()
+	if err != nil {
+		return errors.Wrap(err, "could not fetch canonical head slot")
+	}
+
+	fromSlot := s.orchestratorDB.LatestSavedVerifiedSlot() + 1
+	if lastProcessedSlot := s.LastProcessedSlot(); lastProcessedSlot >= fromSlot {
+		fromSlot = lastProcessedSlot + 1
+	}
+	toSlot := uint64(headSlot)
+	if toSlot < fromSlot || toSlot-fromSlot < pendingBlocksRewind {
+		return nil
+	}
+
+	log.WithField("fromSlot", fromSlot).WithField("toSlot", toSlot).
+		Info("Found gap in vanguard blocks, backfilling missed blocks")
+	s.setBackfillProgress(&types.BackfillProgress{Running: true, FromSlot: fromSlot, ToSlot: toSlot, CurrentSlot: fromSlot})
+
+	processed := uint64(0)
+	for epoch := fromSlot / params.SlotsPerEpoch; epoch <= toSlot/params.SlotsPerEpoch; epoch++ {
+		if s.ctx.Err() != nil {
+			return s.finishBackfill(fromSlot, toSlot, processed, s.ctx.Err())
+		}
+		blocks, err := vanClient.CanonicalBlocksByEpoch(epoch)
+		if err != nil {
+			return s.finishBackfill(fromSlot, toSlot, processed, errors.Wrapf(err, "could not fetch blocks of epoch %d", epoch))
+		}
+
+		for _, block := range blocks {
+			slot := uint64(block.Slot)
+			if slot < fromSlot || slot > toSlot {
+				continue
+			}
+			s.recorder.RecordBeaconBlock(block)
+			if err := s.OnNewPendingVanguardBlock(s.ctx, block); err != nil {
+				return s.finishBackfill(fromSlot, toSlot, processed, errors.Wrapf(err, "could not process block at slot %d", slot))
+			}
+			processed++
+		}
+
+		currentSlot := (epoch+1)*params.SlotsPerEpoch - 1
+		if currentSlot > toSlot {
+			currentSlot = toSlot
+		}
+		s.setBackfillProgress(&types.BackfillProgress{
+			Running:     true,
+			FromSlot:    fromSlot,
+			ToSlot:      toSlot,
+			CurrentSlot: currentSlot,
+			Processed:   processed,
+		})
+		log.WithField("epoch", epoch).WithField("currentSlot", currentSlot).WithField("toSlot", toSlot).
+			WithField("processed", processed).Info("Backfilled vanguard blocks")
+	}
+	return s.finishBackfill(fromSlot, toSlot, processed, nil)
+}
+
+// finishBackfill records the final progress
+func (s *Service) finishBackfill(fromSlot, toSlot, processed uint64, err error) error {
+	progress := &types.BackfillProgress{
+		FromSlot:    fromSlot,
+		ToSlot:      toSlot,
+		CurrentSlot: toSlot,
+		Processed:   processed,
+	}
+	if err != nil {
+		progress.CurrentSlot = s.LastProcessedSlot()
+		progress.Error = err.Error()
+	}
+	s.setBackfillProgress(progress)
+	return err
+}
+
+// setBackfillProgress
+func (s *Service) setBackfillProgress(progress *types.BackfillProgress) {
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+	s.backfillProgress = progress
+}
+
+// BackfillProgress returns the progress of the latest vanguard block backfill. It is nil when no backfill
+// has been needed so far.
+func (s *Service) BackfillProgress() *types.BackfillProgress {
+	s.processingLock.RLock()
+	defer s.processingLock.RUnlock()
+
+	if s.backfillProgress == nil {
+		return nil
+	}
+	progress := *s.backfillProgress
+	return &progress
+}
@@ -0,0 +1,118 @@
+package vanguardchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+	types "github.com/prysmaticlabs/eth2-types"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func newBeaconBlock(slot uint64) *eth.BeaconBlock {
+	return &eth.BeaconBlock{
+		Slot:       types.Slot(slot),
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		Body: &eth.BeaconBlockBody{
+			RandaoReveal: make([]byte, 96),
+			Eth1Data: &eth.Eth1Data{
+				DepositRoot: make([]byte, 32),
+				BlockHash:   make([]byte, 32),
+			},
+			Graffiti:          make([]byte, 32),
+			Attestations:      []*eth.Attestation{},
+			AttesterSlashings: []*eth.AttesterSlashing{},
+			Deposits:          []*eth.Deposit{},
+			ProposerSlashings: []*eth.ProposerSlashing{},
+			VoluntaryExits:    []*eth.SignedVoluntaryExit{},
+			PandoraShard: []*eth.PandoraShard{{
+				ParentHash:  make([]byte, 32),
+				TxHash:      make([]byte, 32),
+				StateRoot:   make([]byte, 32),
+				BlockNumber: slot,
+				ReceiptHash: make([]byte, 32),
+				Signature:   make([]byte, 96),
+				Hash:        make([]byte, 32),
+				SealHash:    make([]byte, 32),
+			}},
+		},
+	}
+}
+
+func TestService_BackfillPendingBlocks(t *testing.T) {
+	defer func() {
+		CanonicalHeadSlotMock = 0
+		CanonicalBlockMocks = nil
+	}()
+
+	ctx := context.Background()
+	vanSvc, _ := SetupVanguardSvc(ctx, t, GRPCFunc)
+
+	// gap is covered by subscription replay
+	CanonicalHeadSlotMock = 20
+	require.NoError(t, vanSvc.backfillPendingBlocks(mockedClient))
+	assert.Equal(t, true, vanSvc.BackfillProgress() == nil)
+
+	// every 7th slot is skipped by vanguard
+	CanonicalHeadSlotMock = 100
+	expectedSlots := make([]uint64, 0)
+	for slot := uint64(1); slot <= 110; slot++ {
+		if slot%7 == 0 {
+			continue
+		}
+		CanonicalBlockMocks = append(CanonicalBlockMocks, newBeaconBlock(slot))
+		if slot <= 100 {
+			expectedSlots = append(expectedSlots, slot)
+		}
+	}
+
+	shardInfoCh := make(chan *eventTypes.VanguardShardInfo, len(CanonicalBlockMocks))
+	sub := vanSvc.SubscribeShardInfoEvent(shardInfoCh)
+	defer sub.Unsubscribe()
+
+	require.NoError(t, vanSvc.backfillPendingBlocks(mockedClient))
+	receivedSlots := make([]uint64, 0, len(expectedSlots))
+	for len(shardInfoCh) > 0 {
+		receivedSlots = append(receivedSlots, (<-shardInfoCh).Slot)
+	}
+	assert.DeepEqual(t, expectedSlots, receivedSlots)
+	assert.Equal(t, uint64(100), vanSvc.LastProcessedSlot())
+	assert.DeepEqual(t, &eventTypes.BackfillProgress{
+		FromSlot:    1,
+		ToSlot:      100,
+		CurrentSlot: 100,
+		Processed:   uint64(len(expectedSlots)),
+	}, vanSvc.BackfillProgress())
+
+	// already processed blocks are not fetched again
+	require.NoError(t, vanSvc.backfillPendingBlocks(mockedClient))
+	assert.Equal(t, 0, len(shardInfoCh))
+}
+
+func TestService_BackfillPendingBlocks_InvalidBlock(t *testing.T) {
+	defer func() {
+		CanonicalHeadSlotMock = 0
+		CanonicalBlockMocks = nil
+	}()
+
+	ctx := context.Background()
+	vanSvc, _ := SetupVanguardSvc(ctx, t, GRPCFunc)
+
+	CanonicalHeadSlotMock = 64
+	for slot := uint64(1); slot <= 64; slot++ {
+		CanonicalBlockMocks = append(CanonicalBlockMocks, newBeaconBlock(slot))
+	}
+	CanonicalBlockMocks[39].Body.PandoraShard = nil
+
+	err := vanSvc.backfillPendingBlocks(mockedClient)
+	assert.ErrorContains(t, "could not process block at slot 40", err)
+	progress := vanSvc.BackfillProgress()
+	require.NotNil(t, progress)
+	assert.Equal(t, false, progress.Running)
+	assert.Equal(t, uint64(39), progress.Processed)
+	assert.Equal(t, uint64(39), progress.CurrentSlot)
+	assert.ErrorContains(t, progress.Error, err)
+}
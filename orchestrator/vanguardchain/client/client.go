@@ -7,15 +7,16 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	"sort"
 	"time"
 )
 
 type VanguardClient interface {
 	CanonicalHeadSlot() (types.Slot, error)
 	StreamNewPendingBlocks(blockRoot []byte, fromSlot types.Slot) (ethpb.BeaconChain_StreamNewPendingBlocksClient, error)
-	StreamMinimalConsensusInfo(epoch uint64) (stream ethpb.BeaconChain_StreamMinimalConsensusInfoClient, err error)
+	StreamMinimalConsensusInfo(ctx context.Context, epoch uint64) (stream ethpb.BeaconChain_StreamMinimalConsensusInfoClient, err error)
+	CanonicalBlocksByEpoch(epoch uint64) ([]*ethpb.BeaconBlock, error)
 	Close()
 }
 
@@ -31,18 +32,19 @@ type GRPCClient struct {
 	validatorClient ethpb.BeaconNodeValidatorClient
 }
 
-// Dial connects a client to the given URL.
+// Dial connects a client to the given URL. Connection is insecure when security config is nil.
 func Dial(ctx context.Context, rawurl string, grpcRetryDelay time.Duration,
-	grpcRetries uint, maxCallRecvMsgSize int) (VanguardClient, error) {
+	grpcRetries uint, maxCallRecvMsgSize int, security *SecurityConfig) (VanguardClient, error) {
 
-	dialOpts := constructDialOptions(
+	dialOpts, err := constructDialOptions(
 		maxCallRecvMsgSize,
-		"",
+		security,
 		grpcRetries,
 		grpcRetryDelay,
 	)
-	if dialOpts == nil {
-		return nil, nil
+	if err != nil {
+		log.WithError(err).Error("Could not get valid credentials")
+		return nil, err
 	}
 
 	c, err := grpc.DialContext(ctx, rawurl, dialOpts...)
@@ -95,12 +97,14 @@ func (vanClient *GRPCClient) StreamNewPendingBlocks(blockRoot []byte, fromSlot t
 	return
 }
 
-func (vanClient *GRPCClient) StreamMinimalConsensusInfo(epoch uint64) (
+// StreamMinimalConsensusInfo subscribes to the consensus infos from the given epoch. The stream is
+// closed when ctx is done.
+func (vanClient *GRPCClient) StreamMinimalConsensusInfo(ctx context.Context, epoch uint64) (
 	stream ethpb.BeaconChain_StreamMinimalConsensusInfoClient,
 	err error,
 ) {
 	stream, err = vanClient.beaconClient.StreamMinimalConsensusInfo(
-		vanClient.ctx,
+		ctx,
 		&ethpb.MinimalConsensusInfoRequest{FromEpoch: types.Epoch(epoch)},
 	)
 	if err != nil {
@@ -111,42 +115,60 @@ func (vanClient *GRPCClient) StreamMinimalConsensusInfo(epoch uint64) (
 	return
 }
 
+// CanonicalBlocksByEpoch returns the canonical blocks of the epoch ordered by slot. It follows the
+// pages of ListBlocks until all blocks of the epoch are fetched.
+func (vanClient *GRPCClient) CanonicalBlocksByEpoch(epoch uint64) ([]*ethpb.BeaconBlock, error) {
+	req := &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: types.Epoch(epoch)}}
+	blocks := make([]*ethpb.BeaconBlock, 0)
+	for {
+		res, err := vanClient.beaconClient.ListBlocks(vanClient.ctx, req)
+		if err != nil {
+			log.WithError(err).WithField("epoch", epoch).Warn("Failed to list blocks")
+			return nil, err
+		}
+		for _, container := range res.BlockContainers {
+			if !container.Canonical || container.Block == nil || container.Block.Block == nil {
+				continue
+			}
+			blocks = append(blocks, container.Block.Block)
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Slot < blocks[j].Slot
+	})
+	return blocks, nil
+}
+
 // constructDialOptions constructs a list of grpc dial options
 func constructDialOptions(
 	maxCallRecvMsgSize int,
-	withCert string,
+	security *SecurityConfig,
 	grpcRetries uint,
 	grpcRetryDelay time.Duration,
 	extraOpts ...grpc.DialOption,
-) []grpc.DialOption {
-	var transportSecurity grpc.DialOption
-	if withCert != "" {
-		creds, err := credentials.NewClientTLSFromFile(withCert, "")
-		if err != nil {
-			log.Errorf("Could not get valid credentials: %v", err)
-			return nil
-		}
-		transportSecurity = grpc.WithTransportCredentials(creds)
-	} else {
-		transportSecurity = grpc.WithInsecure()
-		log.Warn("You are using an insecure gRPC connection. If you are running your beacon node and " +
-			"validator on the same machines, you can ignore this message. If you want to know " +
-			"how to enable secure connections, see: https://docs.prylabs.network/docs/prysm-usage/secure-grpc")
+) ([]grpc.DialOption, error) {
+	securityOpts, err := security.dialOptions()
+	if err != nil {
+		return nil, err
 	}
 
 	if maxCallRecvMsgSize == 0 {
 		maxCallRecvMsgSize = 10 * 5 << 20 // Default 50Mb
 	}
 
-	dialOpts := []grpc.DialOption{
-		transportSecurity,
+	dialOpts := append(securityOpts,
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(maxCallRecvMsgSize),
 			grpc_retry.WithMax(grpcRetries),
 			grpc_retry.WithBackoff(grpc_retry.BackoffLinear(grpcRetryDelay)),
 		),
-	}
+	)
 
 	dialOpts = append(dialOpts, extraOpts...)
-	return dialOpts
+	return dialOpts, nil
 }
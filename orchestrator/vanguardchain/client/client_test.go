@@ -1 +1,77 @@
 package client
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// listBlocksServer serves the blocks of an epoch in pages of two containers
+type listBlocksServer struct {
+	ethpb.UnimplementedBeaconChainServer
+	containers []*ethpb.BeaconBlockContainer
+}
+
+func (s *listBlocksServer) ListBlocks(ctx context.Context, req *ethpb.ListBlocksRequest) (*ethpb.ListBlocksResponse, error) {
+	start := 0
+	if req.PageToken != "" {
+		var err error
+		if start, err = strconv.Atoi(req.PageToken); err != nil {
+			return nil, err
+		}
+	}
+	end := start + 2
+	res := &ethpb.ListBlocksResponse{TotalSize: int32(len(s.containers))}
+	if end < len(s.containers) {
+		res.NextPageToken = strconv.Itoa(end)
+	} else {
+		end = len(s.containers)
+	}
+	res.BlockContainers = s.containers[start:end]
+	return res, nil
+}
+
+func newBlockContainer(slot types.Slot, canonical bool) *ethpb.BeaconBlockContainer {
+	return &ethpb.BeaconBlockContainer{
+		Block:     &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot}},
+		Canonical: canonical,
+	}
+}
+
+func TestGRPCClient_CanonicalBlocksByEpoch(t *testing.T) {
+	server := grpc.NewServer()
+	ethpb.RegisterBeaconChainServer(server, &listBlocksServer{containers: []*ethpb.BeaconBlockContainer{
+		newBlockContainer(36, true),
+		newBlockContainer(33, true),
+		newBlockContainer(34, false),
+		newBlockContainer(35, true),
+		{Canonical: true},
+		newBlockContainer(32, true),
+	}})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	vanClient, err := Dial(context.Background(), listener.Addr().String(), time.Millisecond, 0, 0, nil)
+	require.NoError(t, err)
+	defer vanClient.Close()
+
+	blocks, err := vanClient.CanonicalBlocksByEpoch(1)
+	require.NoError(t, err)
+	slots := make([]types.Slot, 0, len(blocks))
+	for _, block := range blocks {
+		slots = append(slots, block.Slot)
+	}
+	assert.DeepEqual(t, []types.Slot{32, 33, 35, 36}, slots)
+}
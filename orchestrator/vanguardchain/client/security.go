@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	errInvalidCACert      = errors.New("could not append CA certificate to cert pool")
+	errIncompleteKeyPair  = errors.New("both client certificate and client key are required for mutual TLS")
+	errTokenOverPlaintext = errors.New("bearer token requires TLS")
+)
+
+// SecurityConfig holds transport security and authentication settings of the vanguard gRPC connection
+type SecurityConfig struct {
+	// CACert is the path of CA certificate which verifies vanguard's server certificate. TLS is
+	// enabled when it is set or a client certificate is given. System roots are used without CA certificate.
+	CACert string
+	// ClientCert and ClientKey are the paths of client certificate and key for mutual TLS
+	ClientCert string
+	ClientKey  string
+	// BearerToken is sent as authorization metadata with every call. It requires TLS
+	BearerToken string
+}
+
+// tlsEnabled
+func (sc *SecurityConfig) tlsEnabled() bool {
+	return sc != nil && (sc.CACert != "" || sc.ClientCert != "" || sc.ClientKey != "")
+}
+
+// transportCredentials builds TLS credentials from the CA certificate and the client key pair
+func (sc *SecurityConfig) transportCredentials() (credentials.TransportCredentials, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if sc.CACert != "" {
+		pem, err := ioutil.ReadFile(sc.CACert)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read CA certificate")
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(pem) {
+			return nil, errInvalidCACert
+		}
+		tlsCfg.RootCAs = certPool
+	}
+
+	if sc.ClientCert != "" || sc.ClientKey != "" {
+		if sc.ClientCert == "" || sc.ClientKey == "" {
+			return nil, errIncompleteKeyPair
+		}
+		keyPair, err := tls.LoadX509KeyPair(sc.ClientCert, sc.ClientKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load client key pair")
+		}
+		tlsCfg.Certificates = []tls.Certificate{keyPair}
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// dialOptions returns transport security and per rpc credentials dial options
+func (sc *SecurityConfig) dialOptions() ([]grpc.DialOption, error) {
+	if !sc.tlsEnabled() {
+		if sc != nil && sc.BearerToken != "" {
+			return nil, errTokenOverPlaintext
+		}
+		log.Warn("You are using an insecure gRPC connection. If you are running your vanguard node and " +
+			"orchestrator on the same machines, you can ignore this message. If you want to enable secure " +
+			"connections, set the vanguard gRPC CA certificate")
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+
+	creds, err := sc.transportCredentials()
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if sc.BearerToken != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenAuth{token: sc.BearerToken}))
+	}
+	return opts, nil
+}
+
+// tokenAuth sends bearer token as authorization metadata
+type tokenAuth struct {
+	token string
+}
+
+// GetRequestMetadata
+func (t *tokenAuth) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+// RequireTransportSecurity
+func (t *tokenAuth) RequireTransportSecurity() bool {
+	return true
+}
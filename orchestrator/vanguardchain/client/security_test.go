@@ -0,0 +1,251 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testHeadSlot = types.Slot(42)
+
+// beaconChainServer is a stand-in of vanguard node which only serves the chain head
+type beaconChainServer struct {
+	ethpb.UnimplementedBeaconChainServer
+}
+
+func (s *beaconChainServer) GetChainHead(context.Context, *empty.Empty) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{HeadSlot: testHeadSlot}, nil
+}
+
+// testPKI holds the certificate files of a test CA, a server and a client
+type testPKI struct {
+	caCert, serverCert, serverKey, clientCert, clientKey string
+	caPool                                               *x509.CertPool
+}
+
+func writePEM(t *testing.T, path, blockType string, bytes []byte) {
+	require.NoError(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600))
+}
+
+func issueCert(
+	t *testing.T,
+	template *x509.Certificate,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+	certPath, keyPath string,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	writePEM(t, certPath, "CERTIFICATE", der)
+	if keyPath != "" {
+		keyBytes, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		writePEM(t, keyPath, "EC PRIVATE KEY", keyBytes)
+	}
+	return cert, key
+}
+
+func setupPKI(t *testing.T) *testPKI {
+	dir := t.TempDir()
+	pki := &testPKI{
+		caCert:     filepath.Join(dir, "ca.crt"),
+		serverCert: filepath.Join(dir, "server.crt"),
+		serverKey:  filepath.Join(dir, "server.key"),
+		clientCert: filepath.Join(dir, "client.crt"),
+		clientKey:  filepath.Join(dir, "client.key"),
+	}
+	notBefore, notAfter := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+
+	caCert, caKey := issueCert(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil, pki.caCert, "")
+	pki.caPool = x509.NewCertPool()
+	pki.caPool.AddCert(caCert)
+
+	issueCert(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "vanguard"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, caCert, caKey, pki.serverCert, pki.serverKey)
+
+	issueCert(t, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "orchestrator"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, caCert, caKey, pki.clientCert, pki.clientKey)
+	return pki
+}
+
+// startTLSServer starts vanguard stand-in with TLS. Client certificate and bearer token are required when set.
+func startTLSServer(t *testing.T, pki *testPKI, requireClientCert bool, token string) string {
+	keyPair, err := tls.LoadX509KeyPair(pki.serverCert, pki.serverKey)
+	require.NoError(t, err)
+	tlsCfg := &tls.Config{Certificates: []tls.Certificate{keyPair}}
+	if requireClientCert {
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+		tlsCfg.ClientCAs = pki.caPool
+	}
+
+	authInterceptor := func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if token != "" {
+			md, _ := metadata.FromIncomingContext(ctx)
+			if values := md.Get("authorization"); len(values) != 1 || values[0] != "Bearer "+token {
+				return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+			}
+		}
+		return handler(ctx, req)
+	}
+
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsCfg)), grpc.UnaryInterceptor(authInterceptor))
+	ethpb.RegisterBeaconChainServer(server, &beaconChainServer{})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+func TestDial_Security(t *testing.T) {
+	pki := setupPKI(t)
+	tlsAddr := startTLSServer(t, pki, false, "")
+	mtlsAddr := startTLSServer(t, pki, true, "")
+	tokenAddr := startTLSServer(t, pki, false, "secret")
+
+	tests := []struct {
+		name       string
+		addr       string
+		security   *SecurityConfig
+		dialErr    string
+		requestErr bool
+	}{
+		{
+			name:       "insecure client is rejected by TLS server",
+			addr:       tlsAddr,
+			requestErr: true,
+		},
+		{
+			name:     "TLS with CA certificate",
+			addr:     tlsAddr,
+			security: &SecurityConfig{CACert: pki.caCert},
+		},
+		{
+			name:       "TLS without client certificate is rejected by mTLS server",
+			addr:       mtlsAddr,
+			security:   &SecurityConfig{CACert: pki.caCert},
+			requestErr: true,
+		},
+		{
+			name:     "mutual TLS",
+			addr:     mtlsAddr,
+			security: &SecurityConfig{CACert: pki.caCert, ClientCert: pki.clientCert, ClientKey: pki.clientKey},
+		},
+		{
+			name:       "missing bearer token",
+			addr:       tokenAddr,
+			security:   &SecurityConfig{CACert: pki.caCert},
+			requestErr: true,
+		},
+		{
+			name:       "wrong bearer token",
+			addr:       tokenAddr,
+			security:   &SecurityConfig{CACert: pki.caCert, BearerToken: "wrong"},
+			requestErr: true,
+		},
+		{
+			name:     "valid bearer token",
+			addr:     tokenAddr,
+			security: &SecurityConfig{CACert: pki.caCert, BearerToken: "secret"},
+		},
+		{
+			name:     "bearer token without TLS",
+			addr:     tlsAddr,
+			security: &SecurityConfig{BearerToken: "secret"},
+			dialErr:  errTokenOverPlaintext.Error(),
+		},
+		{
+			name:     "client certificate without key",
+			addr:     mtlsAddr,
+			security: &SecurityConfig{CACert: pki.caCert, ClientCert: pki.clientCert},
+			dialErr:  errIncompleteKeyPair.Error(),
+		},
+		{
+			name:     "invalid CA certificate",
+			addr:     tlsAddr,
+			security: &SecurityConfig{CACert: pki.clientKey},
+			dialErr:  errInvalidCACert.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			vanClient, err := Dial(ctx, tt.addr, time.Millisecond, 0, 0, tt.security)
+			if tt.dialErr != "" {
+				assert.ErrorContains(t, tt.dialErr, err)
+				return
+			}
+			require.NoError(t, err)
+			defer vanClient.Close()
+
+			headSlot, err := vanClient.CanonicalHeadSlot()
+			if tt.requestErr {
+				assert.NotNil(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testHeadSlot, headSlot)
+		})
+	}
+}
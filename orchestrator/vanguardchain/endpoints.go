@@ -0,0 +1,156 @@
+package vanguardchain
+
+import (
+	"sync"
+)
+
+const (
+	// maxEndpointScore is the highest health score of a vanguard endpoint
+	maxEndpointScore = 10
+	// minEndpointScore is the lowest health score of a vanguard endpoint
+	minEndpointScore = -10
+	// endpointFailurePenalty is subtracted from the score when the endpoint fails
+	endpointFailurePenalty = 5
+)
+
+// endpoint holds the health score of a vanguard gRPC endpoint
+type endpoint struct {
+	url   string
+	score int
+}
+
+// endpointPool keeps track of the configured vanguard endpoints and selects the healthiest one.
+// Every successful connection increases the endpoint's score and every failure penalizes it, so
+// that a failing endpoint is skipped in favour of others until they fail as well. The endpoint which
+// failed last is always tried last, so that failover does not go back to it immediately.
+type endpointPool struct {
+	lock       sync.RWMutex
+	endpoints  []*endpoint
+	active     string
+	lastFailed string
+}
+
+// newEndpointPool creates pool from the given urls. Empty and duplicated urls are ignored
+func newEndpointPool(urls []string) *endpointPool {
+	pool := &endpointPool{endpoints: make([]*endpoint, 0, len(urls))}
+	seen := make(map[string]bool, len(urls))
+	for _, url := range urls {
+		if url == "" || seen[url] {
+			continue
+		}
+		seen[url] = true
+		pool.endpoints = append(pool.endpoints, &endpoint{url: url})
+	}
+	return pool
+}
+
+// len returns the number of configured endpoints
+func (p *endpointPool) len() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return len(p.endpoints)
+}
+
+// urls returns the configured endpoints in the given order
+func (p *endpointPool) urls() []string {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	urls := make([]string, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		urls = append(urls, e.url)
+	}
+	return urls
+}
+
+// candidates returns the endpoints ordered by score. Endpoints with same score keep the configured order
+// and the endpoint which failed last comes at the end
+func (p *endpointPool) candidates() []string {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	ordered := make([]*endpoint, len(p.endpoints))
+	copy(ordered, p.endpoints)
+	// insertion sort keeps the configured order for same scores
+	for i := 1; i < len(ordered); i++ {
+		for j := i; j > 0 && ordered[j].score > ordered[j-1].score; j-- {
+			ordered[j], ordered[j-1] = ordered[j-1], ordered[j]
+		}
+	}
+
+	urls := make([]string, 0, len(ordered))
+	for _, e := range ordered {
+		if e.url != p.lastFailed {
+			urls = append(urls, e.url)
+		}
+	}
+	if p.find(p.lastFailed) != nil {
+		urls = append(urls, p.lastFailed)
+	}
+	return urls
+}
+
+// markSuccess rewards the endpoint and makes it the active one
+func (p *endpointPool) markSuccess(url string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if e := p.find(url); e != nil && e.score < maxEndpointScore {
+		e.score++
+	}
+	p.active = url
+	if p.lastFailed == url {
+		p.lastFailed = ""
+	}
+}
+
+// markFailure penalizes the endpoint. If it is the active one, there is no active endpoint anymore
+func (p *endpointPool) markFailure(url string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if e := p.find(url); e != nil {
+		e.score -= endpointFailurePenalty
+		if e.score < minEndpointScore {
+			e.score = minEndpointScore
+		}
+	}
+	if p.active == url {
+		p.active = ""
+	}
+	p.lastFailed = url
+}
+
+// activeEndpoint returns the endpoint which is currently in use
+func (p *endpointPool) activeEndpoint() string {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.active
+}
+
+// lastFailedEndpoint returns the endpoint which failed last. It is empty when it has recovered since
+func (p *endpointPool) lastFailedEndpoint() string {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.lastFailed
+}
+
+// score returns the health score of the endpoint
+func (p *endpointPool) score(url string) int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if e := p.find(url); e != nil {
+		return e.score
+	}
+	return 0
+}
+
+func (p *endpointPool) find(url string) *endpoint {
+	for _, e := range p.endpoints {
+		if e.url == url {
+			return e
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package vanguardchain
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/vanguardchain/client"
+	"github.com/lukso-network/lukso-orchestrator/shared/connection"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+)
+
+func TestEndpointPool_Candidates(t *testing.T) {
+	pool := newEndpointPool([]string{"a", "", "b", "a", "c"})
+	assert.DeepEqual(t, []string{"a", "b", "c"}, pool.urls())
+	assert.DeepEqual(t, []string{"a", "b", "c"}, pool.candidates())
+	assert.Equal(t, "", pool.activeEndpoint())
+
+	pool.markSuccess("a")
+	assert.Equal(t, "a", pool.activeEndpoint())
+	assert.Equal(t, 1, pool.score("a"))
+
+	pool.markFailure("a")
+	assert.Equal(t, "", pool.activeEndpoint())
+	assert.Equal(t, "a", pool.lastFailedEndpoint())
+	assert.Equal(t, 1-endpointFailurePenalty, pool.score("a"))
+	assert.DeepEqual(t, []string{"b", "c", "a"}, pool.candidates())
+
+	pool.markSuccess("c")
+	assert.DeepEqual(t, []string{"c", "b", "a"}, pool.candidates())
+
+	for i := 0; i < 10; i++ {
+		pool.markFailure("b")
+		pool.markSuccess("c")
+	}
+	assert.Equal(t, minEndpointScore, pool.score("b"))
+	assert.Equal(t, maxEndpointScore, pool.score("c"))
+}
+
+// Test_VanguardSvc_Failover checks that the service connects to the next endpoint when the dial fails and
+// fails over to another endpoint when the active one fails
+func Test_VanguardSvc_Failover(t *testing.T) {
+	oldReconPeriod := reConPeriod
+	reConPeriod = 10 * time.Millisecond
+	defer func() {
+		reConPeriod = oldReconPeriod
+		CleanConsensusMocks()
+		CleanPendingBlocksMocks()
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vanSvc, _ := SetupVanguardSvc(ctx, t, GRPCFunc)
+	vanSvc.endpoints = newEndpointPool([]string{"primary", "secondary"})
+
+	primaryDown := true
+	dialed := make([]string, 0)
+	vanSvc.dialGRPCFn = DIALGRPCFn(func(endpoint string) (client.VanguardClient, error) {
+		dialed = append(dialed, endpoint)
+		if endpoint == "primary" && primaryDown {
+			return nil, fmt.Errorf("dummy error")
+		}
+		return GRPCFunc(endpoint)
+	})
+
+	vanSvc.waitForConnection()
+	assert.Equal(t, "secondary", vanSvc.ActiveEndpoint())
+	assert.DeepEqual(t, []string{"primary", "secondary"}, dialed)
+
+	// primary is healthy again, but the failed secondary must be replaced by the primary
+	primaryDown = false
+	vanSvc.retryVanguardNode(fmt.Errorf("stream closed"))
+	assert.Equal(t, "primary", vanSvc.ActiveEndpoint())
+	assert.NoError(t, vanSvc.Status())
+	assert.Equal(t, connection.Subscribed, vanSvc.ConnectionState())
+	assert.Equal(t, uint64(1), vanSvc.ConnectionStats().Disconnects)
+	assert.Equal(t, uint64(2), vanSvc.ConnectionStats().Successes)
+}
+
+// Test_VanguardSvc_Status_FailedEndpoint checks that the status names the failed endpoint while failing over
+func Test_VanguardSvc_Status_FailedEndpoint(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vanSvc, _ := SetupVanguardSvc(ctx, t, GRPCFunc)
+	vanSvc.isRunning = true
+	vanSvc.endpoints = newEndpointPool([]string{"primary", "secondary"})
+
+	vanSvc.endpoints.markSuccess("primary")
+	vanSvc.endpoints.markFailure("primary")
+	vanSvc.connManager.Fail(fmt.Errorf("stream closed"))
+	assert.ErrorContains(t, `vanguard endpoint "primary"`, vanSvc.Status())
+}
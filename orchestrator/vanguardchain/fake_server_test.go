@@ -0,0 +1,266 @@
+package vanguardchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/vanguardchain/client"
+	vanTesting "github.com/lukso-network/lukso-orchestrator/orchestrator/vanguardchain/testing"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
+	types "github.com/prysmaticlabs/eth2-types"
+	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// setupFakeServerSvc creates vanguard service which dials the fake vanguard server with the real gRPC client
+func setupFakeServerSvc(t *testing.T) (*Service, db.Database, *vanTesting.FakeBeaconChainServer) {
+	oldReconPeriod := reConPeriod
+	reConPeriod = 50 * time.Millisecond
+	t.Cleanup(func() {
+		reConPeriod = oldReconPeriod
+	})
+
+	ctx := context.Background()
+	fakeServer := vanTesting.NewFakeBeaconChainServer(t)
+	vanSvc, testDB := SetupVanguardSvc(ctx, t, DIALGRPCFn(func(endpoint string) (client.VanguardClient, error) {
+		return client.Dial(ctx, endpoint, time.Millisecond, 0, 0, nil)
+	}))
+	vanSvc.endpoints = newEndpointPool([]string{fakeServer.Endpoint()})
+	t.Cleanup(func() {
+		_ = vanSvc.Stop()
+	})
+	return vanSvc, testDB, fakeServer
+}
+
+// waitForShardInfos waits for shard infos of the given slots in order
+func waitForShardInfos(t *testing.T, ch <-chan *eventTypes.VanguardShardInfo, slots ...uint64) {
+	for _, slot := range slots {
+		select {
+		case shardInfo := <-ch:
+			require.Equal(t, slot, shardInfo.Slot)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for shard info of slot %d", slot)
+		}
+	}
+}
+
+// waitForConsensusInfos waits for consensus infos of the given epochs in order
+func waitForConsensusInfos(t *testing.T, ch <-chan *eventTypes.MinimalEpochConsensusInfoV2, epochs ...uint64) {
+	for _, epoch := range epochs {
+		select {
+		case consensusInfo := <-ch:
+			require.Equal(t, epoch, consensusInfo.Epoch)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for consensus info of epoch %d", epoch)
+		}
+	}
+}
+
+// waitForSubscribers waits until the service has subscribed to both streams of the fake server
+func waitForSubscribers(t *testing.T, fakeServer *vanTesting.FakeBeaconChainServer) {
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if blockSubs, consensusInfoSubs := fakeServer.Subscribers(); blockSubs == 1 && consensusInfoSubs == 1 {
+			return
+		}
+	}
+	t.Fatal("timed out waiting for vanguard service to subscribe")
+}
+
+func Test_VanguardSvc_FakeServer_Streams(t *testing.T) {
+	vanSvc, testDB, fakeServer := setupFakeServerSvc(t)
+	fakeServer.SendConsensusInfo(newVanConsensusInfo(0))
+	fakeServer.SendConsensusInfo(newVanConsensusInfo(1))
+	for slot := uint64(1); slot <= 3; slot++ {
+		fakeServer.SendBlock(newBeaconBlock(slot))
+	}
+
+	shardInfoCh := make(chan *eventTypes.VanguardShardInfo, 10)
+	shardInfoSub := vanSvc.SubscribeShardInfoEvent(shardInfoCh)
+	defer shardInfoSub.Unsubscribe()
+	consensusInfoCh := make(chan *eventTypes.MinimalEpochConsensusInfoV2, 10)
+	consensusInfoSub := vanSvc.SubscribeMinConsensusInfoEvent(consensusInfoCh)
+	defer consensusInfoSub.Unsubscribe()
+
+	vanSvc.Start()
+	waitForConsensusInfos(t, consensusInfoCh, 0, 1)
+	waitForShardInfos(t, shardInfoCh, 1, 2, 3)
+	assert.Equal(t, uint64(1), testDB.GetLatestEpoch())
+
+	// live messages follow the stored history
+	fakeServer.SendBlock(newBeaconBlock(4))
+	waitForShardInfos(t, shardInfoCh, 4)
+	assert.Equal(t, uint64(4), vanSvc.LastProcessedSlot())
+
+	blockRequests := fakeServer.BlockRequests()
+	require.Equal(t, 1, len(blockRequests))
+	assert.Equal(t, types.Slot(1), blockRequests[0].FromSlot)
+
+	// head slot is served by GetChainHead
+	require.NoError(t, vanSvc.updateSyncStatus())
+	assert.Equal(t, uint64(4), vanSvc.SyncStatus().HeadSlot)
+	assert.Equal(t, false, vanSvc.SyncStatus().Syncing)
+}
+
+// Test_VanguardSvc_FakeServer_InvalidConsensusInfo checks that invalid consensus info is rejected without
+// dropping the vanguard stream
+func Test_VanguardSvc_FakeServer_InvalidConsensusInfo(t *testing.T) {
+	vanSvc, testDB, fakeServer := setupFakeServerSvc(t)
+	fakeServer.SendConsensusInfo(newVanConsensusInfo(0))
+	fakeServer.SendConsensusInfo(newVanConsensusInfo(1))
+
+	consensusInfoCh := make(chan *eventTypes.MinimalEpochConsensusInfoV2, 10)
+	consensusInfoSub := vanSvc.SubscribeMinConsensusInfoEvent(consensusInfoCh)
+	defer consensusInfoSub.Unsubscribe()
+
+	vanSvc.Start()
+	waitForConsensusInfos(t, consensusInfoCh, 0, 1)
+
+	invalidInfo := newVanConsensusInfo(2)
+	invalidInfo.ValidatorList[0] = "0x1234"
+	fakeServer.SendConsensusInfo(invalidInfo)
+	for start := time.Now(); len(vanSvc.ConsensusInfoViolations()) == 0; time.Sleep(10 * time.Millisecond) {
+		require.Equal(t, true, time.Since(start) < 5*time.Second, "timed out waiting for violation")
+	}
+	assert.ErrorContains(t, errInvalidConsensusInfo.Error(), vanSvc.Status())
+	assert.Equal(t, uint64(1), testDB.GetLatestEpoch())
+
+	// the same stream delivers the valid consensus info which resolves the violation
+	fakeServer.SendConsensusInfo(newVanConsensusInfo(2))
+	waitForConsensusInfos(t, consensusInfoCh, 2)
+	assert.NoError(t, vanSvc.Status())
+	assert.Equal(t, 1, len(fakeServer.ConsensusInfoRequests()))
+	assert.Equal(t, uint64(0), vanSvc.ConnectionStats().Disconnects)
+}
+
+func Test_VanguardSvc_FakeServer_Disconnect(t *testing.T) {
+	vanSvc, _, fakeServer := setupFakeServerSvc(t)
+	fakeServer.SendConsensusInfo(newVanConsensusInfo(0))
+	fakeServer.SendBlock(newBeaconBlock(1))
+
+	shardInfoCh := make(chan *eventTypes.VanguardShardInfo, 10)
+	shardInfoSub := vanSvc.SubscribeShardInfoEvent(shardInfoCh)
+	defer shardInfoSub.Unsubscribe()
+
+	vanSvc.Start()
+	waitForShardInfos(t, shardInfoCh, 1)
+
+	fakeServer.Disconnect()
+	fakeServer.SendBlock(newBeaconBlock(2))
+	waitForSubscribers(t, fakeServer)
+	// resubscription replays the last processed block, then continues with the missed one
+	waitForShardInfos(t, shardInfoCh, 1, 2)
+
+	blockRequests := fakeServer.BlockRequests()
+	require.Equal(t, 2, len(blockRequests))
+	assert.Equal(t, types.Slot(1), blockRequests[1].FromSlot)
+	assert.Equal(t, true, vanSvc.ConnectionStats().Successes >= 2)
+	assert.Equal(t, true, vanSvc.ConnectionStats().Disconnects+vanSvc.ConnectionStats().Failures >= 1)
+}
+
+func Test_VanguardSvc_FakeServer_Reorg(t *testing.T) {
+	vanSvc, testDB, fakeServer := setupFakeServerSvc(t)
+	for epoch := uint64(0); epoch <= 3; epoch++ {
+		fakeServer.SendConsensusInfo(newVanConsensusInfo(epoch))
+	}
+
+	consensusInfoCh := make(chan *eventTypes.MinimalEpochConsensusInfoV2, 10)
+	consensusInfoSub := vanSvc.SubscribeMinConsensusInfoEvent(consensusInfoCh)
+	defer consensusInfoSub.Unsubscribe()
+	reorgCh := make(chan *eventTypes.ReorgRecord, 1)
+	reorgSub := vanSvc.SubscribeReorgEvent(reorgCh)
+	defer reorgSub.Unsubscribe()
+
+	vanSvc.Start()
+	waitForConsensusInfos(t, consensusInfoCh, 0, 1, 2, 3)
+
+	vanBlockHash := common.HexToHash("0xfcae73c029aa80d9bbc79cda6f23a02fb3bc3d543ca4793456f73125ed9bfecb")
+	panBlockHash := common.HexToHash("0x0b5d32ba8e74ab81d699a585c38bb6d5b62079089d8ff412729fe1fdd3c43497")
+	require.NoError(t, testDB.SaveVerifiedSlotInfo(64, &eventTypes.SlotInfo{
+		VanguardBlockHash: vanBlockHash,
+		PandoraHeaderHash: panBlockHash,
+	}))
+	require.NoError(t, testDB.SaveLatestVerifiedSlot(context.Background()))
+
+	reorgInfo := newVanConsensusInfo(2)
+	reorgInfo.ReorgInfo = &eth.Reorg{
+		VanParentHash: vanBlockHash.Bytes(),
+		PanParentHash: panBlockHash.Bytes(),
+		NewSlot:       66,
+	}
+	fakeServer.SendReorg(reorgInfo)
+	waitForConsensusInfos(t, consensusInfoCh, 2)
+
+	select {
+	case reorgRecord := <-reorgCh:
+		assert.Equal(t, uint64(2), reorgRecord.Epoch)
+		assert.Equal(t, uint64(64), reorgRecord.ParentSlot)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for reorg record")
+	}
+	assert.Equal(t, uint64(2), testDB.GetLatestEpoch())
+
+	// the abandoned epoch is replaced when vanguard supplies the epoch of the new branch
+	fakeServer.SendConsensusInfo(newVanConsensusInfo(3))
+	waitForConsensusInfos(t, consensusInfoCh, 3)
+	assert.Equal(t, uint64(3), testDB.GetLatestEpoch())
+}
+
+// Test_VanguardSvc_FakeServer_Reorg_SpanningEpochs checks that a reorg which spans several epochs keeps the
+// epochs before the reorg consensus info, and that subscribers get the replacement epochs from vanguard
+func Test_VanguardSvc_FakeServer_Reorg_SpanningEpochs(t *testing.T) {
+	vanSvc, testDB, fakeServer := setupFakeServerSvc(t)
+	for epoch := uint64(0); epoch <= 4; epoch++ {
+		fakeServer.SendConsensusInfo(newVanConsensusInfo(epoch))
+	}
+
+	consensusInfoCh := make(chan *eventTypes.MinimalEpochConsensusInfoV2, 10)
+	consensusInfoSub := vanSvc.SubscribeMinConsensusInfoEvent(consensusInfoCh)
+	defer consensusInfoSub.Unsubscribe()
+
+	vanSvc.Start()
+	waitForConsensusInfos(t, consensusInfoCh, 0, 1, 2, 3, 4)
+
+	vanBlockHash := common.HexToHash("0xfcae73c029aa80d9bbc79cda6f23a02fb3bc3d543ca4793456f73125ed9bfecb")
+	panBlockHash := common.HexToHash("0x0b5d32ba8e74ab81d699a585c38bb6d5b62079089d8ff412729fe1fdd3c43497")
+	require.NoError(t, testDB.SaveVerifiedSlotInfo(40, &eventTypes.SlotInfo{
+		VanguardBlockHash: vanBlockHash,
+		PandoraHeaderHash: panBlockHash,
+	}))
+	require.NoError(t, testDB.SaveLatestVerifiedSlot(context.Background()))
+
+	// new slot is in epoch 1, but vanguard replaces the epochs from 3 on
+	reorgInfo := newVanConsensusInfo(3)
+	reorgInfo.ReorgInfo = &eth.Reorg{
+		VanParentHash: vanBlockHash.Bytes(),
+		PanParentHash: panBlockHash.Bytes(),
+		NewSlot:       41,
+	}
+	fakeServer.SendReorg(reorgInfo)
+	waitForConsensusInfos(t, consensusInfoCh, 3)
+	assert.Equal(t, uint64(3), testDB.GetLatestEpoch())
+
+	_, missingEpochs, err := testDB.ConsensusInfosWithGaps(0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(missingEpochs))
+	storedInfo, err := testDB.ConsensusInfo(context.Background(), 4)
+	require.NoError(t, err)
+	assert.Equal(t, true, storedInfo == nil)
+
+	// replacement epoch is forwarded to the subscribers like any live epoch
+	replacement := newVanConsensusInfo(4)
+	replacement.ValidatorList = append([]string{}, replacement.ValidatorList...)
+	replacement.ValidatorList[0], replacement.ValidatorList[1] = replacement.ValidatorList[1], replacement.ValidatorList[0]
+	fakeServer.SendConsensusInfo(replacement)
+	select {
+	case consensusInfo := <-consensusInfoCh:
+		assert.Equal(t, uint64(4), consensusInfo.Epoch)
+		assert.DeepEqual(t, replacement.ValidatorList, consensusInfo.ValidatorList)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for replacement epoch")
+	}
+	assert.Equal(t, uint64(4), testDB.GetLatestEpoch())
+}
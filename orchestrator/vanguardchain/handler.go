@@ -10,20 +10,30 @@ import (
 )
 
 // OnNewConsensusInfo :
-//	- sends the new consensus info to all subscribed pandora clients
+//  - reverts abandoned branch from orchestrator db when the consensus info carries reorg info
 //  - store consensus info into cache as well as into kv consensusInfoDB
+//	- sends the new consensus info to all subscribed pandora clients. After a reorg, the epochs which replace
+//	  the abandoned branch are sent the same way when vanguard supplies them
 func (s *Service) OnNewConsensusInfo(ctx context.Context, consensusInfo *types.MinimalEpochConsensusInfoV2) error {
-	nsent := s.consensusInfoFeed.Send(consensusInfo)
-	log.WithField("nsent", nsent).Trace("Send consensus info to subscribers")
-
+	var reorgRecord *types.ReorgRecord
 	if consensusInfo.ReorgInfo != nil {
 		// reorg happened. So remove info from database
 		log.Info("reorg has been triggered")
-		err := s.orchestratorDB.RevertConsensusInfo(consensusInfo)
+		var err error
+		reorgRecord, err = s.orchestratorDB.RevertConsensusInfo(consensusInfo)
 		if err != nil {
 			log.WithError(err).Error("found error while reverting orchestrator database")
 			return err
 		}
+		log.WithField("epoch", reorgRecord.Epoch).WithField("parentSlot", reorgRecord.ParentSlot).
+			WithField("removedSlots", len(reorgRecord.RemovedSlots)).Info("reorg has been recorded")
+		// blocks after parent slot are from the abandoned branch so they need to be fetched again after failover.
+		// When the parent is not known, the new branch starts at the new slot
+		if reorgRecord.ParentSlot > 0 {
+			s.resetLastProcessedSlot(reorgRecord.ParentSlot)
+		} else {
+			s.resetLastProcessedSlot(consensusInfo.ReorgInfo.NewSlot)
+		}
 	}
 
 	if err := s.orchestratorDB.SaveConsensusInfo(ctx, consensusInfo.ConvertToEpochInfo()); err != nil {
@@ -35,6 +45,13 @@ func (s *Service) OnNewConsensusInfo(ctx context.Context, consensusInfo *types.M
 		log.WithError(err).Warn("failed to save latest epoch into consensusInfoDB!")
 		return err
 	}
+
+	s.resolveViolation()
+	nsent := s.consensusInfoFeed.Send(consensusInfo)
+	log.WithField("nsent", nsent).Trace("Send consensus info to subscribers")
+	if reorgRecord != nil {
+		s.reorgFeed.Send(reorgRecord)
+	}
 	return nil
 }
 
@@ -65,6 +82,7 @@ func (s *Service) OnNewPendingVanguardBlock(ctx context.Context, block *eth.Beac
 		WithField("shardInfoHash", hexutil.Encode(shardInfo.Hash)).
 		Info("New vanguard shard info has arrived")
 
+	s.setLastProcessedSlot(uint64(block.Slot))
 	s.vanguardShardingInfoFeed.Send(cachedShardInfo)
 	return nil
 }
@@ -2,8 +2,11 @@ package vanguardchain
 
 import (
 	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
 	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
 	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
 	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -54,3 +57,40 @@ func TestService_OnNewPendingVanguardBlock(t *testing.T) {
 	time.Sleep(100 * time.Millisecond)
 	assert.LogsContain(t, hook, "New vanguard shard info has arrived")
 }
+
+// TestService_OnNewConsensusInfo_ResetLastProcessedSlot checks that a reorg moves last processed slot back to the
+// reorg parent, or to the new slot when the parent is not known
+func TestService_OnNewConsensusInfo_ResetLastProcessedSlot(t *testing.T) {
+	ctx := context.Background()
+	vanSvc, testDB := SetupVanguardSvc(ctx, t, GRPCFunc)
+	for epoch := uint64(0); epoch <= 3; epoch++ {
+		require.NoError(t, testDB.SaveConsensusInfo(ctx, testutil.NewMinimalConsensusInfo(epoch).ConvertToEpochInfo()))
+	}
+	require.NoError(t, testDB.SaveLatestEpoch(ctx))
+	parentSlotInfo := &eventTypes.SlotInfo{
+		VanguardBlockHash: common.HexToHash("0x01"),
+		PandoraHeaderHash: common.HexToHash("0x02"),
+	}
+	require.NoError(t, testDB.SaveVerifiedSlotInfo(64, parentSlotInfo))
+	require.NoError(t, testDB.SaveLatestVerifiedSlot(ctx))
+
+	// parent is not known, so the new branch starts at the new slot
+	vanSvc.setLastProcessedSlot(100)
+	reorgInfo := testutil.NewMinimalConsensusInfo(3)
+	reorgInfo.ReorgInfo = &eventTypes.Reorg{
+		VanParentHash: common.HexToHash("0x03").Bytes(),
+		PanParentHash: common.HexToHash("0x04").Bytes(),
+		NewSlot:       70,
+	}
+	require.NoError(t, vanSvc.OnNewConsensusInfo(ctx, reorgInfo))
+	assert.Equal(t, uint64(70), vanSvc.LastProcessedSlot())
+
+	reorgInfo = testutil.NewMinimalConsensusInfo(3)
+	reorgInfo.ReorgInfo = &eventTypes.Reorg{
+		VanParentHash: parentSlotInfo.VanguardBlockHash.Bytes(),
+		PanParentHash: parentSlotInfo.PandoraHeaderHash.Bytes(),
+		NewSlot:       66,
+	}
+	require.NoError(t, vanSvc.OnNewConsensusInfo(ctx, reorgInfo))
+	assert.Equal(t, uint64(64), vanSvc.LastProcessedSlot())
+}
@@ -12,3 +12,23 @@ type ConsensusInfoFeed interface {
 type VanguardShardInfoFeed interface {
 	SubscribeShardInfoEvent(chan<- *types.VanguardShardInfo) event.Subscription
 }
+
+type ReorgFeed interface {
+	SubscribeReorgEvent(chan<- *types.ReorgRecord) event.Subscription
+}
+
+type SyncStatusProvider interface {
+	SyncStatus() *types.VanguardSyncStatus
+}
+
+type BackfillProgressProvider interface {
+	BackfillProgress() *types.BackfillProgress
+}
+
+type EndpointProvider interface {
+	ActiveEndpoint() string
+}
+
+type ConsensusInfoViolationProvider interface {
+	ConsensusInfoViolations() []*types.ConsensusInfoViolation
+}
@@ -3,6 +3,7 @@ package vanguardchain
 import (
 	"context"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/cache"
+	"github.com/lukso-network/lukso-orchestrator/orchestrator/capture"
 	"sync"
 	"time"
 
@@ -10,10 +11,13 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/db"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/vanguardchain/client"
+	"github.com/lukso-network/lukso-orchestrator/shared/connection"
 	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
 )
 
-// time to wait before trying to reconnect with the vanguard node.
+// initial time to wait before trying to reconnect with the vanguard node. It grows exponentially
+// with consecutive failures.
 var reConPeriod = 2 * time.Second
 
 type DIALGRPCFn func(endpoint string) (client.VanguardClient, error)
@@ -29,13 +33,25 @@ type Service struct {
 	processingLock sync.RWMutex
 	ctx            context.Context
 	cancel         context.CancelFunc
-	runError       error
 
 	// vanguard chain related attributes
-	connectedVanguard bool
-	vanGRPCEndpoint   string
-	vanGRPCClient     *client.VanguardClient
-	dialGRPCFn        DIALGRPCFn
+	connManager   *connection.Manager
+	endpoints     *endpointPool
+	vanGRPCClient client.VanguardClient
+	dialGRPCFn    DIALGRPCFn
+	// connCtx is cancelled when the subscriptions of the active vanguard connection are closed
+	connCtx    context.Context
+	connCancel context.CancelFunc
+
+	// last processed vanguard block slot. Used to resubscribe after failover
+	lastProcessedSlot uint64
+	// syncStatus is refreshed by polling vanguard's canonical head slot
+	syncStatus *types.VanguardSyncStatus
+	// backfillProgress of the latest missed vanguard blocks backfill
+	backfillProgress *types.BackfillProgress
+	// rejected consensus infos. unresolvedViolation is cleared when a valid consensus info arrives
+	violations          []*types.ConsensusInfoViolation
+	unresolvedViolation *types.ConsensusInfoViolation
 
 	// subscription
 	consensusInfoFeed        event.Feed
@@ -43,16 +59,20 @@ type Service struct {
 	conInfoSubErrCh          chan error
 	conInfoSub               *rpc.ClientSubscription
 	vanguardShardingInfoFeed event.Feed
+	reorgFeed                event.Feed
 	// db support
 	orchestratorDB db.Database
 	// lru cache support
 	shardingInfoCache cache.VanguardShardCache
+	// recorder captures received blocks and consensus infos. Nil when capturing is disabled
+	recorder *capture.Recorder
 }
 
-// NewService creates new service with vanguard endpoint, vanguard namespace and consensusInfoDB
+// NewService creates new service with vanguard endpoints, vanguard namespace and consensusInfoDB.
+// The endpoints are used for failover in the given order of preference
 func NewService(
 	ctx context.Context,
-	vanGRPCEndpoint string,
+	vanGRPCEndpoints []string,
 	db db.Database,
 	cache cache.VanguardShardCache,
 	dialGRPCFn DIALGRPCFn,
@@ -63,7 +83,8 @@ func NewService(
 	return &Service{
 		ctx:               ctx,
 		cancel:            cancel,
-		vanGRPCEndpoint:   vanGRPCEndpoint,
+		connManager:       connection.NewManager("vanguard", connection.DefaultBackoff(reConPeriod)),
+		endpoints:         newEndpointPool(vanGRPCEndpoints),
 		dialGRPCFn:        dialGRPCFn,
 		conInfoSubErrCh:   make(chan error),
 		orchestratorDB:    db,
@@ -73,8 +94,8 @@ func NewService(
 
 // Start a consensus info fetcher service's main event loop.
 func (s *Service) Start() {
-	// Exit early if eth1 endpoint is not set.
-	if s.vanGRPCEndpoint == "" {
+	// Exit early if vanguard endpoint is not set.
+	if s.endpoints.len() == 0 {
 		return
 	}
 	go func() {
@@ -84,6 +105,7 @@ func (s *Service) Start() {
 			log.Info("Context closed, exiting pandora goroutine")
 			return
 		}
+		go s.pollSyncStatus()
 		s.run(s.ctx.Done())
 	}()
 }
@@ -94,6 +116,7 @@ func (s *Service) Stop() error {
 	}
 	s.scope.Close()
 	s.closeClients()
+	s.connManager.Disconnect()
 	return nil
 }
 
@@ -102,64 +125,83 @@ func (s *Service) Status() error {
 	if !s.isRunning {
 		return nil
 	}
-	// get error from run function
-	if s.runError != nil {
-		return s.runError
+	if err := s.connManager.Status(); err != nil {
+		// the failed endpoint is not active anymore while failing over
+		endpoint := s.ActiveEndpoint()
+		if endpoint == "" {
+			endpoint = s.endpoints.lastFailedEndpoint()
+		}
+		return errors.Wrapf(err, "vanguard endpoint %q", endpoint)
+	}
+	if err := s.violationErr(); err != nil {
+		return err
 	}
-	return nil
+	return s.syncStatusErr()
+}
+
+// SetRecorder captures every received vanguard block and consensus info from now on
+func (s *Service) SetRecorder(recorder *capture.Recorder) {
+	s.recorder = recorder
+}
+
+// ConnectionState returns the state of the vanguard connection
+func (s *Service) ConnectionState() connection.State {
+	return s.connManager.State()
 }
 
-// closes down our active eth1 clients.
+// ConnectionStats returns the counters of the vanguard connection
+func (s *Service) ConnectionStats() connection.Stats {
+	return s.connManager.Stats()
+}
+
+// SubscribeConnectionStateEvent subscribes to the state transitions of the vanguard connection
+func (s *Service) SubscribeConnectionStateEvent(ch chan<- *connection.StateEvent) event.Subscription {
+	return s.scope.Track(s.connManager.SubscribeStateEvent(ch))
+}
+
+// ActiveEndpoint returns the vanguard endpoint which is currently in use. It is empty when
+// orchestrator is not connected to any vanguard node.
+func (s *Service) ActiveEndpoint() string {
+	return s.endpoints.activeEndpoint()
+}
+
+// closes down our active vanguard client and its subscriptions.
 func (s *Service) closeClients() {
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
 
+	if s.connCancel != nil {
+		s.connCancel()
+		s.connCancel = nil
+	}
+	if s.vanGRPCClient != nil {
+		s.vanGRPCClient.Close()
+		s.vanGRPCClient = nil
+	}
 }
 
-// waitForConnection waits for a connection with vanguard chain. Until a successful with
-// vanguard chain, it retries again and again.
+// waitForConnection waits for a connection with vanguard chain. Until a successful connection with
+// vanguard chain, it retries with exponential backoff.
 func (s *Service) waitForConnection() {
-	var err error
-	if err = s.connectToVanguardChain(); err == nil {
-		log.WithField("vanguardHttp", s.vanGRPCEndpoint).Info("Connected vanguard chain")
-		s.connectedVanguard = true
+	err := s.connManager.Connect(s.ctx, func() error {
+		log.WithField("endpoints", s.endpoints.candidates()).Debug("Dialing vanguard node")
+		return s.connectToVanguardChain()
+	})
+	if err != nil {
+		log.Debug("Received cancelled context,closing existing vanguard client service")
 		return
 	}
-	log.WithError(err).Warn("Could not connect to vanguard endpoint")
-	s.runError = err
-	ticker := time.NewTicker(reConPeriod)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			log.WithField("endpoint", s.vanGRPCEndpoint).Debugf("Dialing vanguard node")
-			var errConnect error
-			if errConnect = s.connectToVanguardChain(); errConnect != nil {
-				log.WithError(errConnect).Warn("Could not connect to vanguard endpoint")
-				s.runError = errConnect
-				continue
-			}
-			s.connectedVanguard = true
-			s.runError = nil
-			log.WithField("vanguardHttp", s.vanGRPCEndpoint).Info("Connected vanguard chain")
-			return
-		case <-s.ctx.Done():
-			log.Debug("Received cancelled context,closing existing vanguard client service")
-			return
-		}
-	}
+	log.WithField("vanguardEndpoint", s.ActiveEndpoint()).Info("Connected vanguard chain")
 }
 
 // run subscribes to all the services for the ETH1.0 chain.
 func (s *Service) run(done <-chan struct{}) {
-	s.runError = nil
-
 	// the loop waits for any error which comes from consensus info subscription
 	// if any subscription error happens, it will try to reconnect and re-subscribe with vanguard chain again.
 	for {
 		select {
 		case <-done:
 			s.isRunning = false
-			s.runError = nil
 			log.Debug("Context closed, exiting goroutine")
 			return
 		case err := <-s.conInfoSubErrCh:
@@ -173,38 +215,96 @@ func (s *Service) run(done <-chan struct{}) {
 	}
 }
 
-// connectToVanguardChain dials to vanguard chain and creates rpcClient
+// connectToVanguardChain tries the vanguard endpoints from the healthiest one and stops at the first
+// endpoint which accepts the subscriptions.
 func (s *Service) connectToVanguardChain() (err error) {
-	vanguardClient, err := s.dialGRPCFn(s.vanGRPCEndpoint)
-
-	if nil != err {
-		return
+	for _, endpoint := range s.endpoints.candidates() {
+		if err = s.connectToEndpoint(endpoint); err != nil {
+			log.WithError(err).WithField("endpoint", endpoint).Warn("Could not connect to vanguard endpoint")
+			s.endpoints.markFailure(endpoint)
+			continue
+		}
+		s.endpoints.markSuccess(endpoint)
+		return nil
 	}
+	return
+}
 
-	err = s.subscribeVanNewPendingBlockHash(vanguardClient)
-
+// connectToEndpoint dials to vanguard endpoint and subscribes to pending blocks and consensus info
+func (s *Service) connectToEndpoint(endpoint string) error {
+	vanguardClient, err := s.dialGRPCFn(endpoint)
 	if nil != err {
-		return
+		return err
 	}
 
-	err = s.subscribeNewConsensusInfoGRPC(vanguardClient)
+	// close the subscriptions of previous endpoint before subscribing to the new one
+	s.closeClients()
+	s.processingLock.Lock()
+	connCtx, connCancel := context.WithCancel(s.ctx)
+	s.connCtx, s.connCancel = connCtx, connCancel
+	s.vanGRPCClient = vanguardClient
+	s.processingLock.Unlock()
 
-	if nil != err {
-		return
+	// fetch missed blocks before subscribing, so that the subscription continues from the backfilled slot
+	if err := s.backfillPendingBlocks(vanguardClient); err != nil {
+		log.WithError(err).Warn("Could not backfill missed vanguard blocks, relying on subscription replay")
 	}
 
-	return
+	if err := s.subscribeVanNewPendingBlockHash(connCtx, vanguardClient); err != nil {
+		s.closeClients()
+		return err
+	}
+	if err := s.subscribeNewConsensusInfoGRPC(connCtx, vanguardClient); err != nil {
+		s.closeClients()
+		return err
+	}
+	return nil
 }
 
-// Reconnect to vanguard node in case of any failure.
+// Reconnect to vanguard node in case of any failure. The failed endpoint is penalized so that
+// another healthy endpoint is preferred.
 func (s *Service) retryVanguardNode(err error) {
-	s.runError = err
-	s.connectedVanguard = false
-	// Back off for a while before resuming dialing the vanguard node.
-	time.Sleep(reConPeriod)
+	if activeEndpoint := s.ActiveEndpoint(); activeEndpoint != "" {
+		log.WithError(err).WithField("endpoint", activeEndpoint).Warn("Vanguard endpoint failed, failing over")
+		s.endpoints.markFailure(activeEndpoint)
+	}
+	s.closeClients()
+	// waitForConnection backs off for a while before resuming dialing the vanguard node.
+	s.connManager.Fail(err)
 	s.waitForConnection()
-	// Reset run error in the event of a successful connection.
-	s.runError = nil
+}
+
+// sendSubscriptionErr reports subscription error to the run loop unless the connection has been closed already
+func (s *Service) sendSubscriptionErr(ctx context.Context, err error) {
+	select {
+	case s.conInfoSubErrCh <- err:
+	case <-ctx.Done():
+	}
+}
+
+// setLastProcessedSlot
+func (s *Service) setLastProcessedSlot(slot uint64) {
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+	if slot > s.lastProcessedSlot {
+		s.lastProcessedSlot = slot
+	}
+}
+
+// resetLastProcessedSlot moves last processed slot back to the given slot
+func (s *Service) resetLastProcessedSlot(slot uint64) {
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+	if slot < s.lastProcessedSlot {
+		s.lastProcessedSlot = slot
+	}
+}
+
+// LastProcessedSlot returns the slot of latest vanguard block which has been processed
+func (s *Service) LastProcessedSlot() uint64 {
+	s.processingLock.RLock()
+	defer s.processingLock.RUnlock()
+	return s.lastProcessedSlot
 }
 
 // SubscribeMinConsensusInfoEvent registers a subscription of ChainHeadEvent.
@@ -215,3 +315,8 @@ func (s *Service) SubscribeMinConsensusInfoEvent(ch chan<- *types.MinimalEpochCo
 func (s *Service) SubscribeShardInfoEvent(ch chan<- *types.VanguardShardInfo) event.Subscription {
 	return s.scope.Track(s.vanguardShardingInfoFeed.Subscribe(ch))
 }
+
+// SubscribeReorgEvent subscribes to the reorg records which are journaled by this service
+func (s *Service) SubscribeReorgEvent(ch chan<- *types.ReorgRecord) event.Subscription {
+	return s.scope.Track(s.reorgFeed.Subscribe(ch))
+}
@@ -61,7 +61,7 @@ func Test_VanguardSvc_NoServerConn(t *testing.T) {
 
 	ctx := context.Background()
 	vanSvc, _ := SetupVanguardSvc(ctx, t, GRPCFunc)
-	vanSvc.vanGRPCEndpoint = "wsad://invalid.not.reachable!@:BrOKkeeeeeennnnnnnnnn"
+	vanSvc.endpoints = newEndpointPool([]string{"wsad://invalid.not.reachable!@:BrOKkeeeeeennnnnnnnnn"})
 	vanSvc.dialGRPCFn = DIALGRPCFn(func(endpoint string) (client.VanguardClient, error) {
 		return nil, fmt.Errorf("dummy error")
 	})
@@ -91,6 +91,13 @@ func Test_VanguardSvc_RetryToConnServer(t *testing.T) {
 		CleanPendingBlocksMocks()
 	}()
 
+	oldReconPeriod := reConPeriod
+	reConPeriod = time.Millisecond * 50
+
+	defer func() {
+		reConPeriod = oldReconPeriod
+	}()
+
 	ctx := context.Background()
 	vanSvc, _ := SetupVanguardSvc(ctx, t, GRPCFunc)
 	shouldPass := false
@@ -103,13 +110,6 @@ func Test_VanguardSvc_RetryToConnServer(t *testing.T) {
 		return nil, fmt.Errorf("dummy error")
 	})
 
-	oldReconPeriod := reConPeriod
-	reConPeriod = time.Millisecond * 50
-
-	defer func() {
-		reConPeriod = oldReconPeriod
-	}()
-
 	vanSvc.Start()
 	defer func() {
 		_ = vanSvc.Stop()
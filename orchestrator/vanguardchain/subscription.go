@@ -1,15 +1,14 @@
 package vanguardchain
 
 import (
+	"context"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/vanguardchain/client"
-	"github.com/lukso-network/lukso-orchestrator/shared/types"
 	"github.com/pkg/errors"
 	eth2Types "github.com/prysmaticlabs/eth2-types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"time"
 )
 
 var (
@@ -21,6 +20,7 @@ var (
 
 // subscribeVanNewPendingBlockHash
 func (s *Service) subscribeVanNewPendingBlockHash(
+	ctx context.Context,
 	client client.VanguardClient,
 ) error {
 
@@ -38,13 +38,18 @@ func (s *Service) subscribeVanNewPendingBlockHash(
 		blockRoot = latestVerifiedSlotInfo.VanguardBlockHash.Bytes()
 	}
 
+	// after failover, continue from the last processed block instead of the last verified one
+	if lastProcessedSlot := s.LastProcessedSlot(); lastProcessedSlot > latestVerifiedSlot {
+		latestVerifiedSlot = lastProcessedSlot
+	}
+
 	if latestVerifiedSlot == 0 {
 		latestVerifiedSlot = latestVerifiedSlot + 1
 	}
 
 	// subscribe from a safe location.
-	if latestVerifiedSlot > 32 {
-		latestVerifiedSlot -= 32
+	if latestVerifiedSlot > pendingBlocksRewind {
+		latestVerifiedSlot -= pendingBlocksRewind
 	}
 
 	stream, err := client.StreamNewPendingBlocks(blockRoot, eth2Types.Slot(latestVerifiedSlot))
@@ -60,7 +65,7 @@ func (s *Service) subscribeVanNewPendingBlockHash(
 	go func() {
 		for {
 			select {
-			case <-s.ctx.Done():
+			case <-ctx.Done():
 				log.Debug("closing subscribeVanNewPendingBlockHash")
 				return
 			default:
@@ -70,14 +75,17 @@ func (s *Service) subscribeVanNewPendingBlockHash(
 					switch e.Code() {
 					case codes.Canceled, codes.Internal, codes.Unavailable:
 						log.WithError(err).Infof("Trying to restart connection. rpc status: %v", e.Code())
-						s.conInfoSubErrCh <- err
+						s.sendSubscriptionErr(ctx, err)
 						return
 					}
 				}
+				if vanBlock != nil {
+					s.recorder.RecordBeaconBlock(vanBlock)
+				}
 
 				if err := s.OnNewPendingVanguardBlock(s.ctx, vanBlock); err != nil {
 					log.WithError(err).Error("Failed to process the pending vanguard shardInfo")
-					s.conInfoSubErrCh <- errShardInfoProcess
+					s.sendSubscriptionErr(ctx, errShardInfoProcess)
 					return
 				}
 			}
@@ -87,7 +95,7 @@ func (s *Service) subscribeVanNewPendingBlockHash(
 }
 
 // subscribeNewConsensusInfoGRPC
-func (s *Service) subscribeNewConsensusInfoGRPC(client client.VanguardClient) error {
+func (s *Service) subscribeNewConsensusInfoGRPC(ctx context.Context, client client.VanguardClient) error {
 	fromEpoch := s.orchestratorDB.LatestSavedEpoch()
 	log.WithField("fromEpoch", fromEpoch).Debug("initial from value subscribeNewConsensusInfoGRPC")
 	for i := s.orchestratorDB.LatestSavedEpoch(); i >= 0; {
@@ -103,7 +111,7 @@ func (s *Service) subscribeNewConsensusInfoGRPC(client client.VanguardClient) er
 		i--
 	}
 	log.WithField("fromEpoch", fromEpoch).Debug("requesting from value subscribeNewConsensusInfoGRPC")
-	stream, err := client.StreamMinimalConsensusInfo(fromEpoch)
+	stream, err := client.StreamMinimalConsensusInfo(s.ctx, fromEpoch)
 	if nil != err {
 		log.WithError(err).Error("Failed to subscribe to stream of new consensus info")
 		return err
@@ -115,7 +123,7 @@ func (s *Service) subscribeNewConsensusInfoGRPC(client client.VanguardClient) er
 	go func() {
 		for {
 			select {
-			case <-s.ctx.Done():
+			case <-ctx.Done():
 				log.Info("Received cancelled context, closing existing consensus info subscription")
 				return
 
@@ -125,45 +133,40 @@ func (s *Service) subscribeNewConsensusInfoGRPC(client client.VanguardClient) er
 					switch e.Code() {
 					case codes.Canceled, codes.Internal, codes.Unavailable:
 						log.WithError(err).Infof("Trying to restart connection. rpc status: %v", e.Code())
-						s.conInfoSubErrCh <- err
+						s.sendSubscriptionErr(ctx, err)
 						return
 					}
 				}
 
 				if nil == vanMinimalConsensusInfo {
 					log.Error("Received nil consensus info")
-					s.conInfoSubErrCh <- errConsensusInfoNil
+					s.sendSubscriptionErr(ctx, errConsensusInfoNil)
 					return
 				}
+				s.recorder.RecordConsensusInfo(vanMinimalConsensusInfo)
 
-				consensusInfo := &types.MinimalEpochConsensusInfoV2{
-					Epoch:            uint64(vanMinimalConsensusInfo.Epoch),
-					ValidatorList:    vanMinimalConsensusInfo.ValidatorList,
-					EpochStartTime:   vanMinimalConsensusInfo.EpochTimeStart,
-					SlotTimeDuration: time.Duration(vanMinimalConsensusInfo.SlotTimeDuration.Seconds),
-				}
-				// if re-org happens then we get this info not nil
-				if vanMinimalConsensusInfo.ReorgInfo != nil {
-					reorgInfo := &types.Reorg{
-						VanParentHash: vanMinimalConsensusInfo.ReorgInfo.VanParentHash,
-						PanParentHash: vanMinimalConsensusInfo.ReorgInfo.PanParentHash,
-						NewSlot: uint64(vanMinimalConsensusInfo.ReorgInfo.NewSlot),
-					}
-					consensusInfo.ReorgInfo = reorgInfo
-				}
-				// Only non empty check for now
-				if len(consensusInfo.ValidatorList) < 1 {
-					log.WithField("consensusInfo", consensusInfo).WithField("err", err).Error(
-						"empty validator list")
-					s.conInfoSubErrCh <- errInvalidValidatorLength
-					return
-				}
+				consensusInfo := toConsensusInfoV2(vanMinimalConsensusInfo)
 
 				log.WithField("epoch", vanMinimalConsensusInfo.Epoch).
 					WithField("epochInfo", fmt.Sprintf("%+v", vanMinimalConsensusInfo)).
 					Debug("Received new consensus info for next epoch")
+				// vanguard skipped some epochs. Fetch them before processing the live epoch
+				if latestEpoch := s.orchestratorDB.GetLatestEpoch(); consensusInfo.ReorgInfo == nil &&
+					consensusInfo.Epoch > latestEpoch+1 {
+					if err := s.backfillConsensusInfos(client, latestEpoch+1, consensusInfo.Epoch); err != nil {
+						log.WithError(err).Error("Failed to backfill missing consensus infos")
+						s.sendSubscriptionErr(ctx, errBackfillConsensusInfo)
+						return
+					}
+				}
+				// invalid consensus info is not stored. The violation is reported by the service status
+				// until a valid consensus info arrives, so the stream is kept alive
+				if err := s.validateConsensusInfo(consensusInfo); err != nil {
+					s.recordViolation(consensusInfo, err)
+					continue
+				}
 				if err := s.OnNewConsensusInfo(s.ctx, consensusInfo); err != nil {
-					s.conInfoSubErrCh <- errConsensusInfoProcess
+					s.sendSubscriptionErr(ctx, errConsensusInfoProcess)
 					return
 				}
 			}
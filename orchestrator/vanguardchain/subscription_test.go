@@ -100,15 +100,28 @@ func TestService_OnNewConsensusInfo(t *testing.T) {
 		consensusInfos, currentErr := newTestDB.ConsensusInfos(reorgInfoEpoch2.Epoch)
 		require.NoError(t, currentErr)
 		require.Equal(t, true, len(consensusInfos) == int(nonReorgInfo.Epoch-reorgInfoEpoch2.Epoch)+1)
+
+		reorgCh := make(chan *types.ReorgRecord, 1)
+		reorgSub := vanSvc.SubscribeReorgEvent(reorgCh)
+		defer reorgSub.Unsubscribe()
 		require.NoError(t, vanSvc.OnNewConsensusInfo(ctx, reorgInfoEpoch2))
 
+		reorgRecord := <-reorgCh
+		require.Equal(t, reorgInfoEpoch2.Epoch, reorgRecord.Epoch)
+		require.Equal(t, reorgInfoEpoch2.Epoch*32, reorgRecord.ParentSlot)
+		reorgRecords, currentErr := newTestDB.ReorgRecords(0, 0)
+		require.NoError(t, currentErr)
+		require.DeepEqual(t, []*types.ReorgRecord{reorgRecord}, reorgRecords)
+
 		consensusInfos, currentErr = newTestDB.ConsensusInfos(reorgInfoEpoch2.Epoch)
 		require.NoError(t, currentErr)
 		require.Equal(t, true, len(consensusInfos) == 1)
 		require.Equal(t, reorgInfoEpoch2.Epoch, newTestDB.LatestSavedEpoch())
 
+		// consensus infos of the abandoned branch must be removed
 		fetchedConsensus, currentErr := newTestDB.ConsensusInfo(ctx, nonReorgInfo.Epoch)
 		require.NoError(t, currentErr)
-		require.Equal(t, false, nil == fetchedConsensus)
+		require.Equal(t, true, nil == fetchedConsensus)
+		require.Equal(t, reorgInfoEpoch2.Epoch, newTestDB.GetLatestEpoch())
 	})
 }
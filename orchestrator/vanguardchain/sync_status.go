@@ -0,0 +1,92 @@
+package vanguardchain
+
+import (
+	"time"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/params"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	// syncStatusPollPeriod is the interval to poll vanguard's canonical head slot
+	syncStatusPollPeriod = 12 * time.Second
+	// maxSyncDistance is the number of slots which orchestrator may lag behind before it is syncing
+	maxSyncDistance = uint64(params.SlotsPerEpoch)
+)
+
+var errVanguardSyncing = errors.New("orchestrator is syncing with vanguard chain")
+
+// pollSyncStatus updates sync status periodically until the service stops
+func (s *Service) pollSyncStatus() {
+	if err := s.updateSyncStatus(); err != nil {
+		log.WithError(err).Debug("Could not update vanguard sync status")
+	}
+	ticker := time.NewTicker(syncStatusPollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.updateSyncStatus(); err != nil {
+				log.WithError(err).Debug("Could not update vanguard sync status")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// updateSyncStatus compares vanguard's canonical head slot with the latest received and verified slots
+func (s *Service) updateSyncStatus() error {
+	s.processingLock.RLock()
+	vanClient := s.vanGRPCClient
+	s.processingLock.RUnlock()
+	if vanClient == nil {
+		return errors.New("vanguard client is not connected")
+	}
+
+	headSlot, err := vanClient.CanonicalHeadSlot()
+	if err != nil {
+		return errors.Wrap(err, "could not fetch canonical head slot")
+	}
+
+	syncStatus := types.NewVanguardSyncStatus(
+		uint64(headSlot),
+		s.LastProcessedSlot(),
+		s.orchestratorDB.LatestSavedVerifiedSlot(),
+		maxSyncDistance,
+	)
+	log.WithField("headSlot", syncStatus.HeadSlot).
+		WithField("receivedDistance", syncStatus.ReceivedDistance).
+		WithField("verifiedDistance", syncStatus.VerifiedDistance).
+		WithField("syncing", syncStatus.Syncing).
+		Trace("Updated vanguard sync status")
+
+	s.processingLock.Lock()
+	s.syncStatus = syncStatus
+	s.processingLock.Unlock()
+	return nil
+}
+
+// SyncStatus returns the latest sync status. It is nil until vanguard's head slot has been fetched once
+func (s *Service) SyncStatus() *types.VanguardSyncStatus {
+	s.processingLock.RLock()
+	defer s.processingLock.RUnlock()
+
+	if s.syncStatus == nil {
+		return nil
+	}
+	syncStatus := *s.syncStatus
+	return &syncStatus
+}
+
+// syncStatusErr returns error when orchestrator lags too far behind vanguard
+func (s *Service) syncStatusErr() error {
+	syncStatus := s.SyncStatus()
+	if syncStatus == nil || !syncStatus.Syncing {
+		return nil
+	}
+	return errors.Wrapf(errVanguardSyncing, "%d slots behind head slot %d",
+		syncStatus.ReceivedDistance, syncStatus.HeadSlot)
+}
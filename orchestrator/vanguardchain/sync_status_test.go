@@ -0,0 +1,42 @@
+package vanguardchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+func TestService_UpdateSyncStatus(t *testing.T) {
+	defer func() {
+		CanonicalHeadSlotMock = 0
+	}()
+
+	ctx := context.Background()
+	vanSvc, _ := SetupVanguardSvc(ctx, t, GRPCFunc)
+	assert.Equal(t, true, vanSvc.SyncStatus() == nil)
+	assert.ErrorContains(t, "vanguard client is not connected", vanSvc.updateSyncStatus())
+
+	vanSvc.vanGRPCClient = mockedClient
+	vanSvc.setLastProcessedSlot(10)
+	CanonicalHeadSlotMock = types.Slot(10 + maxSyncDistance + 1)
+	require.NoError(t, vanSvc.updateSyncStatus())
+
+	syncStatus := vanSvc.SyncStatus()
+	require.NotNil(t, syncStatus)
+	assert.Equal(t, true, syncStatus.Syncing)
+	assert.Equal(t, uint64(CanonicalHeadSlotMock), syncStatus.HeadSlot)
+	assert.Equal(t, uint64(10), syncStatus.LatestReceivedSlot)
+	assert.Equal(t, maxSyncDistance+1, syncStatus.ReceivedDistance)
+	assert.Equal(t, uint64(CanonicalHeadSlotMock), syncStatus.VerifiedDistance)
+	assert.ErrorContains(t, errVanguardSyncing.Error(), vanSvc.syncStatusErr())
+
+	vanSvc.setLastProcessedSlot(uint64(CanonicalHeadSlotMock))
+	require.NoError(t, vanSvc.updateSyncStatus())
+	syncStatus = vanSvc.SyncStatus()
+	assert.Equal(t, false, syncStatus.Syncing)
+	assert.Equal(t, uint64(0), syncStatus.ReceivedDistance)
+	assert.NoError(t, vanSvc.syncStatusErr())
+}
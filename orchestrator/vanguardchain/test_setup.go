@@ -9,6 +9,7 @@ import (
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/rpc/api/events"
 	"github.com/lukso-network/lukso-orchestrator/orchestrator/vanguardchain/client"
 	"github.com/lukso-network/lukso-orchestrator/shared/mock"
+	"github.com/lukso-network/lukso-orchestrator/shared/params"
 	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
 	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
 	eventTypes "github.com/lukso-network/lukso-orchestrator/shared/types"
@@ -31,6 +32,8 @@ type vanClientMock struct {
 
 var (
 	ConsensusInfoMocks        []*eth.MinimalConsensusInfo
+	CanonicalHeadSlotMock     types.Slot
+	CanonicalBlockMocks       []*eth.BeaconBlock
 	PendingBlockMocks         []*eth.BeaconBlock
 	mockedStreamPendingBlocks eth.BeaconChain_StreamNewPendingBlocksClient = streamNewPendingBlocksClient{
 		pendingBlocks: PendingBlockMocks,
@@ -87,10 +90,20 @@ func (s streamNewPendingBlocksClient) RecvMsg(m interface{}) error {
 }
 
 func (v vanClientMock) CanonicalHeadSlot() (types.Slot, error) {
-	panic("implement me")
+	return CanonicalHeadSlotMock, nil
 }
 
-func (v vanClientMock) StreamMinimalConsensusInfo(epoch uint64) (stream eth.BeaconChain_StreamMinimalConsensusInfoClient, err error) {
+func (v vanClientMock) CanonicalBlocksByEpoch(epoch uint64) ([]*eth.BeaconBlock, error) {
+	blocks := make([]*eth.BeaconBlock, 0)
+	for _, block := range CanonicalBlockMocks {
+		if uint64(block.Slot)/params.SlotsPerEpoch == epoch {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks, nil
+}
+
+func (v vanClientMock) StreamMinimalConsensusInfo(ctx context.Context, epoch uint64) (stream eth.BeaconChain_StreamMinimalConsensusInfoClient, err error) {
 	return v.consensusInfoClient, nil
 }
 
@@ -98,9 +111,7 @@ func (v vanClientMock) StreamNewPendingBlocks(blockRoot []byte, fromSlot types.S
 	return v.pendingBlocksClient, nil
 }
 
-func (v vanClientMock) Close() {
-	panic("implement me")
-}
+func (v vanClientMock) Close() {}
 
 type streamConsensusInfoClient struct {
 	consensusInfos []*eth.MinimalConsensusInfo
@@ -190,7 +201,7 @@ func SetupVanguardSvc(
 
 	vanguardClientService, err := NewService(
 		ctx,
-		"127.0.0.1:4000",
+		[]string{"127.0.0.1:4000"},
 		newTestDB,
 		cache.NewVanShardInfoCache(1<<10),
 		dialGRPCFn,
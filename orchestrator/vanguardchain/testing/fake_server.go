@@ -0,0 +1,278 @@
+// Package testing provides an in-process fake of vanguard's BeaconChain gRPC server for tests which
+// exercise the real vanguard gRPC client.
+package testing
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// subscriberBufferSize is the number of undelivered messages after which a slow subscriber is disconnected
+const subscriberBufferSize = 1024
+
+// FakeBeaconChainServer serves scripted blocks and consensus infos over a real gRPC listener.
+// Like vanguard, a new stream first replays the stored history from the requested slot or epoch and
+// then follows the live messages.
+type FakeBeaconChainServer struct {
+	ethpb.UnimplementedBeaconChainServer
+
+	server   *grpc.Server
+	listener net.Listener
+
+	lock              sync.Mutex
+	headSlot          types.Slot
+	blocks            []*ethpb.BeaconBlock
+	consensusInfos    []*ethpb.MinimalConsensusInfo
+	blockSubs         map[chan *ethpb.BeaconBlock]struct{}
+	consensusInfoSubs map[chan *ethpb.MinimalConsensusInfo]struct{}
+	blockRequests     []*ethpb.StreamPendingBlocksRequest
+	consensusRequests []*ethpb.MinimalConsensusInfoRequest
+}
+
+// NewFakeBeaconChainServer starts the fake server on a random local port. It is stopped at the end of the test.
+func NewFakeBeaconChainServer(t testing.TB) *FakeBeaconChainServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("could not listen for fake vanguard server: %v", err)
+	}
+	fake := &FakeBeaconChainServer{
+		server:            grpc.NewServer(),
+		listener:          listener,
+		blockSubs:         make(map[chan *ethpb.BeaconBlock]struct{}),
+		consensusInfoSubs: make(map[chan *ethpb.MinimalConsensusInfo]struct{}),
+	}
+	ethpb.RegisterBeaconChainServer(fake.server, fake)
+	go func() {
+		_ = fake.server.Serve(listener)
+	}()
+	t.Cleanup(fake.Stop)
+	return fake
+}
+
+// Endpoint returns the address which the vanguard client dials
+func (s *FakeBeaconChainServer) Endpoint() string {
+	return s.listener.Addr().String()
+}
+
+// Stop closes the listener and all active streams
+func (s *FakeBeaconChainServer) Stop() {
+	s.Disconnect()
+	s.server.Stop()
+}
+
+// SetHeadSlot sets the canonical head slot. Sending a block moves the head slot forward as well.
+func (s *FakeBeaconChainServer) SetHeadSlot(slot types.Slot) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.headSlot = slot
+}
+
+// SendBlock stores the block and sends it to the pending block streams
+func (s *FakeBeaconChainServer) SendBlock(block *ethpb.BeaconBlock) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.blocks = append(s.blocks, block)
+	if block.Slot > s.headSlot {
+		s.headSlot = block.Slot
+	}
+	for ch := range s.blockSubs {
+		select {
+		case ch <- block:
+		default:
+			s.closeBlockSub(ch)
+		}
+	}
+}
+
+// SendConsensusInfo stores the consensus info and sends it to the consensus info streams
+func (s *FakeBeaconChainServer) SendConsensusInfo(consensusInfo *ethpb.MinimalConsensusInfo) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.consensusInfos = append(s.consensusInfos, consensusInfo)
+	for ch := range s.consensusInfoSubs {
+		select {
+		case ch <- consensusInfo:
+		default:
+			s.closeConsensusInfoSub(ch)
+		}
+	}
+}
+
+// SendReorg drops the blocks after the new slot of the reorg info and the consensus infos from the
+// reorged epoch, then sends the consensus info which carries the reorg info.
+func (s *FakeBeaconChainServer) SendReorg(consensusInfo *ethpb.MinimalConsensusInfo) {
+	s.lock.Lock()
+	if consensusInfo.ReorgInfo != nil {
+		blocks := make([]*ethpb.BeaconBlock, 0, len(s.blocks))
+		for _, block := range s.blocks {
+			if block.Slot <= consensusInfo.ReorgInfo.NewSlot {
+				blocks = append(blocks, block)
+			}
+		}
+		s.blocks = blocks
+		s.headSlot = consensusInfo.ReorgInfo.NewSlot
+	}
+	consensusInfos := make([]*ethpb.MinimalConsensusInfo, 0, len(s.consensusInfos))
+	for _, info := range s.consensusInfos {
+		if info.Epoch < consensusInfo.Epoch {
+			consensusInfos = append(consensusInfos, info)
+		}
+	}
+	s.consensusInfos = consensusInfos
+	s.lock.Unlock()
+
+	s.SendConsensusInfo(consensusInfo)
+}
+
+// Disconnect closes all active streams with unavailable status. The server keeps accepting new streams.
+func (s *FakeBeaconChainServer) Disconnect() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for ch := range s.blockSubs {
+		s.closeBlockSub(ch)
+	}
+	for ch := range s.consensusInfoSubs {
+		s.closeConsensusInfoSub(ch)
+	}
+}
+
+// Subscribers returns the number of active pending block and consensus info streams
+func (s *FakeBeaconChainServer) Subscribers() (blockSubs int, consensusInfoSubs int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.blockSubs), len(s.consensusInfoSubs)
+}
+
+// BlockRequests returns the requests of all pending block streams in arrival order
+func (s *FakeBeaconChainServer) BlockRequests() []*ethpb.StreamPendingBlocksRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]*ethpb.StreamPendingBlocksRequest{}, s.blockRequests...)
+}
+
+// ConsensusInfoRequests returns the requests of all consensus info streams in arrival order
+func (s *FakeBeaconChainServer) ConsensusInfoRequests() []*ethpb.MinimalConsensusInfoRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]*ethpb.MinimalConsensusInfoRequest{}, s.consensusRequests...)
+}
+
+// GetChainHead returns the head slot
+func (s *FakeBeaconChainServer) GetChainHead(context.Context, *emptypb.Empty) (*ethpb.ChainHead, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return &ethpb.ChainHead{HeadSlot: s.headSlot}, nil
+}
+
+// StreamNewPendingBlocks streams the stored blocks from the requested slot and then the new blocks
+func (s *FakeBeaconChainServer) StreamNewPendingBlocks(
+	req *ethpb.StreamPendingBlocksRequest,
+	stream ethpb.BeaconChain_StreamNewPendingBlocksServer,
+) error {
+	ch := make(chan *ethpb.BeaconBlock, subscriberBufferSize)
+	s.lock.Lock()
+	s.blockRequests = append(s.blockRequests, req)
+	history := make([]*ethpb.BeaconBlock, 0, len(s.blocks))
+	for _, block := range s.blocks {
+		if block.Slot >= req.FromSlot {
+			history = append(history, block)
+		}
+	}
+	s.blockSubs[ch] = struct{}{}
+	s.lock.Unlock()
+
+	defer s.removeBlockSub(ch)
+	for _, block := range history {
+		if err := stream.Send(block); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case block, ok := <-ch:
+			if !ok {
+				return status.Error(codes.Unavailable, "fake vanguard server disconnected")
+			}
+			if err := stream.Send(block); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, stream.Context().Err().Error())
+		}
+	}
+}
+
+// StreamMinimalConsensusInfo streams the stored consensus infos from the requested epoch and then the new ones
+func (s *FakeBeaconChainServer) StreamMinimalConsensusInfo(
+	req *ethpb.MinimalConsensusInfoRequest,
+	stream ethpb.BeaconChain_StreamMinimalConsensusInfoServer,
+) error {
+	ch := make(chan *ethpb.MinimalConsensusInfo, subscriberBufferSize)
+	s.lock.Lock()
+	s.consensusRequests = append(s.consensusRequests, req)
+	history := make([]*ethpb.MinimalConsensusInfo, 0, len(s.consensusInfos))
+	for _, consensusInfo := range s.consensusInfos {
+		if consensusInfo.Epoch >= req.FromEpoch {
+			history = append(history, consensusInfo)
+		}
+	}
+	s.consensusInfoSubs[ch] = struct{}{}
+	s.lock.Unlock()
+
+	defer s.removeConsensusInfoSub(ch)
+	for _, consensusInfo := range history {
+		if err := stream.Send(consensusInfo); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case consensusInfo, ok := <-ch:
+			if !ok {
+				return status.Error(codes.Unavailable, "fake vanguard server disconnected")
+			}
+			if err := stream.Send(consensusInfo); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, stream.Context().Err().Error())
+		}
+	}
+}
+
+// closeBlockSub must be called with the lock held
+func (s *FakeBeaconChainServer) closeBlockSub(ch chan *ethpb.BeaconBlock) {
+	delete(s.blockSubs, ch)
+	close(ch)
+}
+
+// closeConsensusInfoSub must be called with the lock held
+func (s *FakeBeaconChainServer) closeConsensusInfoSub(ch chan *ethpb.MinimalConsensusInfo) {
+	delete(s.consensusInfoSubs, ch)
+	close(ch)
+}
+
+func (s *FakeBeaconChainServer) removeBlockSub(ch chan *ethpb.BeaconBlock) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.blockSubs[ch]; ok {
+		s.closeBlockSub(ch)
+	}
+}
+
+func (s *FakeBeaconChainServer) removeConsensusInfoSub(ch chan *ethpb.MinimalConsensusInfo) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.consensusInfoSubs[ch]; ok {
+		s.closeConsensusInfoSub(ch)
+	}
+}
@@ -0,0 +1,140 @@
+package vanguardchain
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/params"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// blsPublicKeyLength is the length of compressed BLS12-381 public key
+	blsPublicKeyLength = 48
+	// maxRecordedViolations is the number of latest violations which are kept for inspection
+	maxRecordedViolations = 100
+	// bls compressed encoding flags of the first byte
+	blsCompressionFlag = 0x80
+	blsInfinityFlag    = 0x40
+)
+
+var (
+	errNonContiguousEpoch    = errors.New("consensus info epoch is not contiguous")
+	errInvalidPublicKey      = errors.New("validator public key is not a well-formed BLS public key")
+	errInvalidEpochStartTime = errors.New("consensus info epoch start time does not follow previous epoch")
+	errInvalidConsensusInfo  = errors.New("Received invalid consensus info")
+)
+
+// validateConsensusInfo checks that the consensus info continues the stored chain of epochs.
+//  - epochs are contiguous unless the consensus info carries reorg info
+//  - validator list has one proposer for every slot of the epoch
+//  - every validator is a compressed 48-byte BLS public key
+//  - epoch start time advances by exactly one epoch from the previous epoch
+func (s *Service) validateConsensusInfo(consensusInfo *types.MinimalEpochConsensusInfoV2) error {
+	latestEpoch := s.orchestratorDB.GetLatestEpoch()
+	latestInfo, err := s.orchestratorDB.ConsensusInfo(s.ctx, latestEpoch)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch latest consensus info")
+	}
+	var previousInfo *types.MinimalEpochConsensusInfo
+	if consensusInfo.Epoch > 0 {
+		if previousInfo, err = s.orchestratorDB.ConsensusInfo(s.ctx, consensusInfo.Epoch-1); err != nil {
+			return errors.Wrap(err, "could not fetch previous consensus info")
+		}
+	}
+	// the first consensus info of empty db can not be compared to anything
+	if latestInfo != nil {
+		if consensusInfo.Epoch > 0 && previousInfo == nil {
+			return errors.Wrapf(errNonContiguousEpoch, "previous epoch of epoch %d is missing", consensusInfo.Epoch)
+		}
+		// vanguard replays the latest epoch after resubscription. Older epochs require reorg
+		if consensusInfo.ReorgInfo == nil && consensusInfo.Epoch < latestEpoch {
+			return errors.Wrapf(errNonContiguousEpoch, "epoch %d is older than latest epoch %d without reorg",
+				consensusInfo.Epoch, latestEpoch)
+		}
+	}
+
+	if len(consensusInfo.ValidatorList) != int(params.SlotsPerEpoch) {
+		return errors.Wrapf(errInvalidValidatorLength, "got %d validators, want %d",
+			len(consensusInfo.ValidatorList), params.SlotsPerEpoch)
+	}
+	for i, pubKey := range consensusInfo.ValidatorList {
+		if err := validatePublicKey(pubKey); err != nil {
+			return errors.Wrapf(err, "validator %d", i)
+		}
+	}
+
+	if previousInfo == nil {
+		return nil
+	}
+	// slot time duration holds the number of seconds per slot
+	expectedStartTime := previousInfo.EpochStartTime + params.SlotsPerEpoch*uint64(consensusInfo.SlotTimeDuration)
+	if consensusInfo.EpochStartTime != expectedStartTime {
+		return errors.Wrapf(errInvalidEpochStartTime, "got %d, want %d", consensusInfo.EpochStartTime, expectedStartTime)
+	}
+	return nil
+}
+
+// validatePublicKey checks that the hex encoded public key has the compressed encoding of a non infinity point
+func validatePublicKey(pubKey string) error {
+	pubKeyBytes, err := hexutil.Decode(pubKey)
+	if err != nil {
+		return errors.Wrap(errInvalidPublicKey, err.Error())
+	}
+	if len(pubKeyBytes) != blsPublicKeyLength {
+		return errors.Wrapf(errInvalidPublicKey, "got %d bytes", len(pubKeyBytes))
+	}
+	if pubKeyBytes[0]&blsCompressionFlag == 0 || pubKeyBytes[0]&blsInfinityFlag != 0 {
+		return errors.Wrap(errInvalidPublicKey, "invalid compression flags")
+	}
+	return nil
+}
+
+// recordViolation keeps the rejected consensus info for inspection
+func (s *Service) recordViolation(consensusInfo *types.MinimalEpochConsensusInfoV2, err error) {
+	violation := &types.ConsensusInfoViolation{
+		Epoch:     consensusInfo.Epoch,
+		Reason:    err.Error(),
+		Timestamp: uint64(time.Now().Unix()),
+	}
+	log.WithField("epoch", violation.Epoch).WithField("reason", violation.Reason).
+		Error("Rejected invalid consensus info")
+
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+	s.violations = append(s.violations, violation)
+	if len(s.violations) > maxRecordedViolations {
+		s.violations = s.violations[len(s.violations)-maxRecordedViolations:]
+	}
+	s.unresolvedViolation = violation
+}
+
+// resolveViolation is called when a valid consensus info has been processed after a violation
+func (s *Service) resolveViolation() {
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+	s.unresolvedViolation = nil
+}
+
+// ConsensusInfoViolations returns the latest rejected consensus infos, oldest first
+func (s *Service) ConsensusInfoViolations() []*types.ConsensusInfoViolation {
+	s.processingLock.RLock()
+	defer s.processingLock.RUnlock()
+
+	violations := make([]*types.ConsensusInfoViolation, len(s.violations))
+	copy(violations, s.violations)
+	return violations
+}
+
+// violationErr returns error while the latest received consensus info is invalid
+func (s *Service) violationErr() error {
+	s.processingLock.RLock()
+	defer s.processingLock.RUnlock()
+
+	if s.unresolvedViolation == nil {
+		return nil
+	}
+	return errors.Wrapf(errInvalidConsensusInfo, "epoch %d: %s",
+		s.unresolvedViolation.Epoch, s.unresolvedViolation.Reason)
+}
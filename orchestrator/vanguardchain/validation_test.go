@@ -0,0 +1,141 @@
+package vanguardchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+)
+
+func TestService_ValidateConsensusInfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		consensusInfo func() *types.MinimalEpochConsensusInfoV2
+		err           error
+	}{
+		{
+			name:          "next epoch",
+			consensusInfo: func() *types.MinimalEpochConsensusInfoV2 { return testutil.NewMinimalConsensusInfo(3) },
+		},
+		{
+			name:          "replayed latest epoch",
+			consensusInfo: func() *types.MinimalEpochConsensusInfoV2 { return testutil.NewMinimalConsensusInfo(2) },
+		},
+		{
+			name:          "gap",
+			consensusInfo: func() *types.MinimalEpochConsensusInfoV2 { return testutil.NewMinimalConsensusInfo(4) },
+			err:           errNonContiguousEpoch,
+		},
+		{
+			name:          "older epoch without reorg",
+			consensusInfo: func() *types.MinimalEpochConsensusInfoV2 { return testutil.NewMinimalConsensusInfo(1) },
+			err:           errNonContiguousEpoch,
+		},
+		{
+			name: "older epoch with reorg",
+			consensusInfo: func() *types.MinimalEpochConsensusInfoV2 {
+				consensusInfo := testutil.NewMinimalConsensusInfo(1)
+				consensusInfo.ReorgInfo = &types.Reorg{NewSlot: 33}
+				return consensusInfo
+			},
+		},
+		{
+			name: "short validator list",
+			consensusInfo: func() *types.MinimalEpochConsensusInfoV2 {
+				consensusInfo := testutil.NewMinimalConsensusInfo(3)
+				consensusInfo.ValidatorList = consensusInfo.ValidatorList[1:]
+				return consensusInfo
+			},
+			err: errInvalidValidatorLength,
+		},
+		{
+			name: "public key with wrong length",
+			consensusInfo: func() *types.MinimalEpochConsensusInfoV2 {
+				consensusInfo := testutil.NewMinimalConsensusInfo(3)
+				consensusInfo.ValidatorList[5] = consensusInfo.ValidatorList[5][:len(consensusInfo.ValidatorList[5])-2]
+				return consensusInfo
+			},
+			err: errInvalidPublicKey,
+		},
+		{
+			name: "public key which is not hex",
+			consensusInfo: func() *types.MinimalEpochConsensusInfoV2 {
+				consensusInfo := testutil.NewMinimalConsensusInfo(3)
+				consensusInfo.ValidatorList[5] = "validator"
+				return consensusInfo
+			},
+			err: errInvalidPublicKey,
+		},
+		{
+			name: "uncompressed public key",
+			consensusInfo: func() *types.MinimalEpochConsensusInfoV2 {
+				consensusInfo := testutil.NewMinimalConsensusInfo(3)
+				consensusInfo.ValidatorList[5] = hexutil.Encode(make([]byte, blsPublicKeyLength))
+				return consensusInfo
+			},
+			err: errInvalidPublicKey,
+		},
+		{
+			name: "infinity public key",
+			consensusInfo: func() *types.MinimalEpochConsensusInfoV2 {
+				consensusInfo := testutil.NewMinimalConsensusInfo(3)
+				pubKey := make([]byte, blsPublicKeyLength)
+				pubKey[0] = blsCompressionFlag | blsInfinityFlag
+				consensusInfo.ValidatorList[5] = hexutil.Encode(pubKey)
+				return consensusInfo
+			},
+			err: errInvalidPublicKey,
+		},
+		{
+			name: "wrong epoch start time",
+			consensusInfo: func() *types.MinimalEpochConsensusInfoV2 {
+				consensusInfo := testutil.NewMinimalConsensusInfo(3)
+				consensusInfo.EpochStartTime++
+				return consensusInfo
+			},
+			err: errInvalidEpochStartTime,
+		},
+	}
+
+	ctx := context.Background()
+	vanSvc, db := SetupVanguardSvc(ctx, t, GRPCFunc)
+	for epoch := uint64(0); epoch <= 2; epoch++ {
+		require.NoError(t, db.SaveConsensusInfo(ctx, testutil.NewMinimalConsensusInfo(epoch).ConvertToEpochInfo()))
+		require.NoError(t, db.SaveLatestEpoch(ctx))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vanSvc.validateConsensusInfo(tt.consensusInfo())
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, tt.err.Error(), err)
+		})
+	}
+}
+
+func TestService_RecordViolation(t *testing.T) {
+	ctx := context.Background()
+	vanSvc, _ := SetupVanguardSvc(ctx, t, GRPCFunc)
+	assert.NoError(t, vanSvc.violationErr())
+
+	for epoch := uint64(0); epoch < maxRecordedViolations+5; epoch++ {
+		vanSvc.recordViolation(&types.MinimalEpochConsensusInfoV2{Epoch: epoch}, errInvalidEpochStartTime)
+	}
+	violations := vanSvc.ConsensusInfoViolations()
+	require.Equal(t, maxRecordedViolations, len(violations))
+	assert.Equal(t, uint64(5), violations[0].Epoch)
+	assert.Equal(t, errInvalidEpochStartTime.Error(), violations[0].Reason)
+	assert.ErrorContains(t, errInvalidConsensusInfo.Error(), vanSvc.violationErr())
+
+	// valid consensus info resolves the violation but keeps the record
+	require.NoError(t, vanSvc.OnNewConsensusInfo(ctx, testutil.NewMinimalConsensusInfo(0)))
+	assert.NoError(t, vanSvc.violationErr())
+	assert.Equal(t, maxRecordedViolations, len(vanSvc.ConsensusInfoViolations()))
+}
@@ -12,9 +12,13 @@ const (
 	DefaultHTTPPort             = 8545        // Default TCP port for the HTTP RPC server
 	DefaultWSHost               = "localhost" // Default host interface for the websocket RPC server
 	DefaultWSPort               = 8546        // Default TCP port for the websocket RPC server
+	DefaultHTTPVirtualHosts     = "localhost" // Default virtual host which is accepted by the HTTP RPC server
 	DefaultIpcPath              = "orchestrator.ipc"
 	DefaultVanguardGRPCEndpoint = "127.0.0.1:4000"
 	DefaultPandoraRPCEndpoint   = "http://127.0.0.1:8545"
+	DefaultPandoraRPCNamespace  = "eth"
+	BoltDBBackend               = "bolt"   // Persistent bolt db backend
+	MemoryDBBackend             = "memory" // Ephemeral in-memory db backend
 )
 
 // DefaultConfigDir is the default config directory to use for the vaults and other
@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli/v2"
 )
 
@@ -45,6 +48,51 @@ var (
 		Value: DefaultHTTPPort,
 	}
 
+	HTTPCORSDomainFlag = &cli.StringSliceFlag{
+		Name:  "http.corsdomain",
+		Usage: "Comma separated list of domains from which to accept cross origin requests (browser enforced)",
+	}
+
+	HTTPVirtualHostsFlag = &cli.StringSliceFlag{
+		Name:  "http.vhosts",
+		Usage: "Comma separated list of virtual hostnames from which to accept requests (server enforced). Accepts '*' wildcard.",
+		Value: cli.NewStringSlice(DefaultHTTPVirtualHosts),
+	}
+
+	HTTPApiFlag = &cli.StringSliceFlag{
+		Name:  "http.api",
+		Usage: "Comma separated list of API modules offered over the HTTP-RPC interface. All public modules by default, admin must be listed explicitly",
+	}
+
+	HTTPPathPrefixFlag = &cli.StringFlag{
+		Name:  "http.rpcprefix",
+		Usage: "HTTP path prefix on which JSON-RPC is served. Use '/' to serve on all paths.",
+	}
+
+	HTTPReadTimeoutFlag = &cli.DurationFlag{
+		Name:  "http.readtimeout",
+		Usage: "Maximum duration for reading an entire HTTP-RPC request",
+		Value: rpc.DefaultHTTPTimeouts.ReadTimeout,
+	}
+
+	HTTPWriteTimeoutFlag = &cli.DurationFlag{
+		Name:  "http.writetimeout",
+		Usage: "Maximum duration before timing out writes of an HTTP-RPC response",
+		Value: rpc.DefaultHTTPTimeouts.WriteTimeout,
+	}
+
+	HTTPIdleTimeoutFlag = &cli.DurationFlag{
+		Name:  "http.idletimeout",
+		Usage: "Maximum amount of time to wait for the next request when HTTP-RPC keep-alives are enabled",
+		Value: rpc.DefaultHTTPTimeouts.IdleTimeout,
+	}
+
+	HTTPReadyMaxSlotLagFlag = &cli.Uint64Flag{
+		Name:  "http.ready.maxslotlag",
+		Usage: "Maximum number of slots the latest verified slot may lag behind the vanguard head for /ready to succeed",
+		Value: 32,
+	}
+
 	WSEnabledFlag = &cli.BoolFlag{
 		Name:  "ws",
 		Usage: "Enable the WS-RPC server",
@@ -62,10 +110,50 @@ var (
 		Value: DefaultWSPort,
 	}
 
-	VanguardGRPCEndpoint = &cli.StringFlag{
-		Name:  "vanguard-grpc-endpoint",
-		Usage: "Vanguard node gRPC provider endpoint",
-		Value: DefaultVanguardGRPCEndpoint,
+	WSApiFlag = &cli.StringSliceFlag{
+		Name:  "ws.api",
+		Usage: "Comma separated list of API modules offered over the WS-RPC interface. All public modules by default, admin must be listed explicitly",
+	}
+
+	WSAllowedOriginsFlag = &cli.StringSliceFlag{
+		Name:  "ws.origins",
+		Usage: "Comma separated list of origins from which to accept websocket requests. Accepts '*' wildcard. Only localhost by default",
+	}
+
+	WSPathPrefixFlag = &cli.StringFlag{
+		Name:  "ws.rpcprefix",
+		Usage: "HTTP path prefix on which JSON-RPC is served over WS. Use '/' to serve on all paths.",
+	}
+
+	VanguardGRPCEndpoint = &cli.StringSliceFlag{
+		Name: "vanguard-grpc-endpoint",
+		Usage: "Vanguard node gRPC provider endpoints. Multiple endpoints can be given as comma separated list " +
+			"or by repeating the flag. Orchestrator fails over to the next healthy endpoint when the active one fails",
+		Value: cli.NewStringSlice(DefaultVanguardGRPCEndpoint),
+	}
+
+	// VanguardGRPCCACertFlag enables TLS for the vanguard gRPC connection.
+	VanguardGRPCCACertFlag = &cli.StringFlag{
+		Name:  "vanguard-grpc-ca-cert",
+		Usage: "Path to the CA certificate which signed vanguard node's gRPC server certificate. Enables TLS",
+	}
+
+	// VanguardGRPCClientCertFlag enables mutual TLS for the vanguard gRPC connection.
+	VanguardGRPCClientCertFlag = &cli.StringFlag{
+		Name:  "vanguard-grpc-client-cert",
+		Usage: "Path to the client certificate which is presented to vanguard node for mutual TLS",
+	}
+
+	// VanguardGRPCClientKeyFlag is the private key of the vanguard gRPC client certificate.
+	VanguardGRPCClientKeyFlag = &cli.StringFlag{
+		Name:  "vanguard-grpc-client-key",
+		Usage: "Path to the private key of the client certificate for mutual TLS",
+	}
+
+	// VanguardGRPCTokenFlag is sent as bearer token with every vanguard gRPC call.
+	VanguardGRPCTokenFlag = &cli.StringFlag{
+		Name:  "vanguard-grpc-token",
+		Usage: "Bearer token which is sent as authorization metadata with every vanguard gRPC call",
 	}
 
 	// PandoraRPCEndpoint provides an WSS/IPC access endpoint to an Pandora RPC.
@@ -75,6 +163,38 @@ var (
 		Value: DefaultPandoraRPCEndpoint,
 	}
 
+	// PandoraRPCNamespaceFlag is the namespace of pandora's pending headers api.
+	PandoraRPCNamespaceFlag = &cli.StringFlag{
+		Name:  "pandora-rpc-namespace",
+		Usage: "Namespace of pandora node's pending headers subscription and header queries",
+		Value: DefaultPandoraRPCNamespace,
+	}
+
+	// PandoraJWTSecretFlag authenticates orchestrator with pandora's RPC.
+	PandoraJWTSecretFlag = &cli.StringFlag{
+		Name:  "pandora-jwt-secret",
+		Usage: "Path to a file containing the hex encoded 32 byte secret which signs the JWT tokens sent to pandora RPC",
+	}
+
+	// PandoraPollIntervalFlag is used when pandora endpoint does not support subscriptions.
+	PandoraPollIntervalFlag = &cli.DurationFlag{
+		Name:  "pandora-poll-interval",
+		Usage: "Interval of polling pandora node for new headers when its endpoint does not support subscriptions, like http endpoints",
+		Value: time.Second,
+	}
+
+	// CaptureFileFlag records the received vanguard and pandora messages for later replay.
+	CaptureFileFlag = &cli.StringFlag{
+		Name:  "capture-file",
+		Usage: "Records every received vanguard block, consensus info and pandora header with its arrival time to the given file. An existing file is overwritten",
+	}
+
+	// ReplayFileFlag feeds the node from a capture file instead of vanguard and pandora nodes.
+	ReplayFileFlag = &cli.StringFlag{
+		Name:  "replay-file",
+		Usage: "Replays the given capture file with its original timing instead of connecting to vanguard and pandora nodes",
+	}
+
 	// VerbosityFlag defines the logrus configuration.
 	VerbosityFlag = &cli.StringFlag{
 		Name:  "verbosity",
@@ -82,6 +202,13 @@ var (
 		Value: "info",
 	}
 
+	// DBBackendFlag specifies which database backend the node uses.
+	DBBackendFlag = &cli.StringFlag{
+		Name:  "db-backend",
+		Usage: "Database backend. Supports: bolt, memory. The memory backend loses everything on shutdown and is meant for throwaway devnets",
+		Value: BoltDBBackend,
+	}
+
 	// BoltMMapInitialSizeFlag specifies the initial size in bytes of boltdb's mmap syscall.
 	BoltMMapInitialSizeFlag = &cli.IntFlag{
 		Name:  "bolt-mmap-initial-size",
@@ -0,0 +1,56 @@
+package connection
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+const (
+	// DefaultMaxBackoff is the longest period between two connection attempts
+	DefaultMaxBackoff = time.Minute
+	// DefaultBackoffMultiplier grows the period after every failed attempt
+	DefaultBackoffMultiplier = 2
+	// DefaultBackoffJitter randomizes the period by +/- 20%
+	DefaultBackoffJitter = 0.2
+)
+
+// Backoff computes exponentially growing periods with random jitter between connection attempts
+type Backoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+	// Jitter is the fraction of the period which is randomly added or subtracted. 0 disables jitter
+	Jitter float64
+}
+
+// DefaultBackoff returns backoff which starts with the given period
+func DefaultBackoff(initial time.Duration) Backoff {
+	return Backoff{
+		Initial:    initial,
+		Max:        DefaultMaxBackoff,
+		Multiplier: DefaultBackoffMultiplier,
+		Jitter:     DefaultBackoffJitter,
+	}
+}
+
+// Duration returns the period to wait after the given number of consecutive failures.
+// The first failure waits the initial period.
+func (b Backoff) Duration(failures uint64) time.Duration {
+	if failures == 0 || b.Initial <= 0 {
+		return 0
+	}
+	multiplier := b.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	period := float64(b.Initial) * math.Pow(multiplier, float64(failures-1))
+	if b.Max > 0 && period > float64(b.Max) {
+		period = float64(b.Max)
+	}
+	if b.Jitter > 0 {
+		period += period * b.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(period)
+}
@@ -0,0 +1,27 @@
+package connection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+)
+
+func TestBackoff_Duration(t *testing.T) {
+	backoff := Backoff{Initial: time.Second, Max: 10 * time.Second, Multiplier: 2}
+	assert.Equal(t, time.Duration(0), backoff.Duration(0))
+	assert.Equal(t, time.Second, backoff.Duration(1))
+	assert.Equal(t, 2*time.Second, backoff.Duration(2))
+	assert.Equal(t, 8*time.Second, backoff.Duration(4))
+	assert.Equal(t, 10*time.Second, backoff.Duration(5))
+	assert.Equal(t, 10*time.Second, backoff.Duration(100))
+}
+
+func TestBackoff_Jitter(t *testing.T) {
+	backoff := DefaultBackoff(time.Second)
+	for i := 0; i < 100; i++ {
+		period := backoff.Duration(2)
+		assert.Equal(t, true, period >= 1600*time.Millisecond && period <= 2400*time.Millisecond,
+			"period %v is out of jitter range", period)
+	}
+}
@@ -0,0 +1,5 @@
+package connection
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "connection")
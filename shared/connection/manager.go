@@ -0,0 +1,234 @@
+package connection
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/pkg/errors"
+)
+
+// State of the connection with a chain node
+type State int
+
+const (
+	// Disconnected means that there is no connection and no attempt is running
+	Disconnected State = iota
+	// Connecting means that dialing and subscribing is in progress
+	Connecting
+	// Subscribed means that the connection is established and all subscriptions are running
+	Subscribed
+	// Degraded means that the connection or a subscription failed and the next attempt is waiting for backoff
+	Degraded
+)
+
+// String
+func (s State) String() string {
+	switch s {
+	case Disconnected:
+		return "disconnected"
+	case Connecting:
+		return "connecting"
+	case Subscribed:
+		return "subscribed"
+	case Degraded:
+		return "degraded"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrNotSubscribed is returned by Status when the connection is not subscribed
+var ErrNotSubscribed = errors.New("connection is not subscribed")
+
+// StateEvent is sent on every state transition
+type StateEvent struct {
+	Name string
+	From State
+	To   State
+	Err  error
+	Time time.Time
+}
+
+// Stats holds the counters of a connection
+type Stats struct {
+	State               State
+	Attempts            uint64
+	Failures            uint64
+	ConsecutiveFailures uint64
+	Successes           uint64
+	Disconnects         uint64
+	LastError           string
+	LastTransition      time.Time
+}
+
+// StatsProvider is implemented by the chain services which own a connection manager
+type StatsProvider interface {
+	ConnectionStats() Stats
+}
+
+// Manager drives the connection state machine of a chain node. It retries the connect function with
+// exponential backoff, keeps counters and sends state transition events to the subscribers.
+type Manager struct {
+	name    string
+	backoff Backoff
+
+	lock                sync.RWMutex
+	state               State
+	attempts            uint64
+	failures            uint64
+	consecutiveFailures uint64
+	successes           uint64
+	disconnects         uint64
+	lastErr             error
+	lastTransition      time.Time
+
+	stateFeed event.Feed
+}
+
+// NewManager creates a disconnected connection manager
+func NewManager(name string, backoff Backoff) *Manager {
+	return &Manager{
+		name:           name,
+		backoff:        backoff,
+		lastTransition: time.Now(),
+	}
+}
+
+// Connect calls connectFn until it succeeds or the context is cancelled. After a failure it waits for the
+// backoff period before the next attempt.
+func (m *Manager) Connect(ctx context.Context, connectFn func() error) error {
+	for {
+		if wait := m.backoff.Duration(m.ConsecutiveFailures()); wait > 0 {
+			log.WithField("name", m.name).WithField("backoff", wait).Debug("Waiting before next connection attempt")
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				m.transition(Disconnected, ctx.Err())
+				return ctx.Err()
+			}
+		}
+		if ctx.Err() != nil {
+			m.transition(Disconnected, ctx.Err())
+			return ctx.Err()
+		}
+
+		m.lock.Lock()
+		m.attempts++
+		m.lock.Unlock()
+		m.transition(Connecting, nil)
+
+		if err := connectFn(); err != nil {
+			m.Fail(err)
+			continue
+		}
+
+		m.lock.Lock()
+		m.successes++
+		m.consecutiveFailures = 0
+		m.lastErr = nil
+		m.lock.Unlock()
+		m.transition(Subscribed, nil)
+		return nil
+	}
+}
+
+// Fail records a failed connection attempt or a broken subscription and moves to degraded state
+func (m *Manager) Fail(err error) {
+	m.lock.Lock()
+	if m.state == Subscribed {
+		m.disconnects++
+	}
+	m.failures++
+	m.consecutiveFailures++
+	m.lastErr = err
+	m.lock.Unlock()
+	m.transition(Degraded, err)
+}
+
+// Disconnect moves to disconnected state, e.g. when the service stops
+func (m *Manager) Disconnect() {
+	m.lock.Lock()
+	if m.state == Subscribed {
+		m.disconnects++
+	}
+	m.lock.Unlock()
+	m.transition(Disconnected, nil)
+}
+
+// State returns the current state
+func (m *Manager) State() State {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.state
+}
+
+// IsSubscribed
+func (m *Manager) IsSubscribed() bool {
+	return m.State() == Subscribed
+}
+
+// ConsecutiveFailures returns the number of failures since the last successful connection
+func (m *Manager) ConsecutiveFailures() uint64 {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.consecutiveFailures
+}
+
+// Stats returns a snapshot of the counters
+func (m *Manager) Stats() Stats {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	stats := Stats{
+		State:               m.state,
+		Attempts:            m.attempts,
+		Failures:            m.failures,
+		ConsecutiveFailures: m.consecutiveFailures,
+		Successes:           m.successes,
+		Disconnects:         m.disconnects,
+		LastTransition:      m.lastTransition,
+	}
+	if m.lastErr != nil {
+		stats.LastError = m.lastErr.Error()
+	}
+	return stats
+}
+
+// Status returns nil when subscribed. Otherwise it returns the last error which caused the state
+func (m *Manager) Status() error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	if m.state == Subscribed {
+		return nil
+	}
+	if m.lastErr != nil {
+		return errors.Wrapf(m.lastErr, "%s connection is %s", m.name, m.state)
+	}
+	return errors.Wrapf(ErrNotSubscribed, "%s connection is %s", m.name, m.state)
+}
+
+// SubscribeStateEvent subscribes to state transitions
+func (m *Manager) SubscribeStateEvent(ch chan<- *StateEvent) event.Subscription {
+	return m.stateFeed.Subscribe(ch)
+}
+
+// transition changes the state and notifies subscribers
+func (m *Manager) transition(to State, err error) {
+	m.lock.Lock()
+	from := m.state
+	if from == to {
+		m.lock.Unlock()
+		return
+	}
+	m.state = to
+	m.lastTransition = time.Now()
+	stateEvent := &StateEvent{Name: m.name, From: from, To: to, Err: err, Time: m.lastTransition}
+	m.lock.Unlock()
+
+	log.WithField("name", m.name).WithField("from", from).WithField("to", to).
+		Debug("Connection state has been changed")
+	m.stateFeed.Send(stateEvent)
+}
@@ -0,0 +1,82 @@
+package connection
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+)
+
+func TestManager_Connect(t *testing.T) {
+	manager := NewManager("test", Backoff{Initial: time.Millisecond, Multiplier: 2})
+	assert.Equal(t, Disconnected, manager.State())
+	assert.ErrorContains(t, "test connection is disconnected", manager.Status())
+
+	events := make(chan *StateEvent, 20)
+	sub := manager.SubscribeStateEvent(events)
+	defer sub.Unsubscribe()
+
+	errDial := errors.New("dial failed")
+	attempts := 0
+	require.NoError(t, manager.Connect(context.Background(), func() error {
+		attempts++
+		if attempts < 3 {
+			return errDial
+		}
+		return nil
+	}))
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, Subscribed, manager.State())
+	assert.Equal(t, true, manager.IsSubscribed())
+	assert.NoError(t, manager.Status())
+
+	stats := manager.Stats()
+	assert.Equal(t, uint64(3), stats.Attempts)
+	assert.Equal(t, uint64(2), stats.Failures)
+	assert.Equal(t, uint64(0), stats.ConsecutiveFailures)
+	assert.Equal(t, uint64(1), stats.Successes)
+	assert.Equal(t, "", stats.LastError)
+
+	// subscription breaks
+	manager.Fail(errors.New("stream closed"))
+	assert.Equal(t, Degraded, manager.State())
+	assert.ErrorContains(t, "test connection is degraded: stream closed", manager.Status())
+	assert.Equal(t, uint64(1), manager.Stats().Disconnects)
+
+	manager.Disconnect()
+	assert.Equal(t, Disconnected, manager.State())
+
+	expected := []State{Connecting, Degraded, Connecting, Degraded, Connecting, Subscribed, Degraded, Disconnected}
+	for _, state := range expected {
+		select {
+		case stateEvent := <-events:
+			assert.Equal(t, state, stateEvent.To)
+			assert.Equal(t, "test", stateEvent.Name)
+		case <-time.After(time.Second):
+			t.Fatalf("missing transition to %s", state)
+		}
+	}
+}
+
+func TestManager_Connect_Cancelled(t *testing.T) {
+	manager := NewManager("test", Backoff{Initial: time.Hour})
+	manager.Fail(errors.New("stream closed"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	attempts := 0
+	err := manager.Connect(ctx, func() error {
+		attempts++
+		return nil
+	})
+	assert.ErrorContains(t, context.Canceled.Error(), err)
+	assert.Equal(t, 0, attempts)
+	assert.Equal(t, Disconnected, manager.State())
+}
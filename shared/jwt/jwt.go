@@ -0,0 +1,126 @@
+// Package jwt signs and verifies the short-lived HS256 tokens which authenticate orchestrator
+// with pandora's RPC, in the same way as execution clients authenticate consensus clients.
+package jwt
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SecretLength is the length of the shared secret in bytes
+	SecretLength = 32
+	// MaxIssuedAtDrift is the maximum difference between the issued at claim and the current time
+	MaxIssuedAtDrift = 60 * time.Second
+)
+
+var (
+	errInvalidSecretLength = errors.New("jwt secret must be 32 bytes")
+	errMalformedToken      = errors.New("malformed jwt token")
+	errUnsupportedAlg      = errors.New("unsupported jwt signing algorithm")
+	errInvalidSignature    = errors.New("invalid jwt signature")
+	errStaleToken          = errors.New("jwt issued at claim is too far from current time")
+
+	// encoded header is the same for every token
+	tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+)
+
+// header of the token
+type header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+// claims of the token. Only the issued at claim is required
+type claims struct {
+	IssuedAt int64 `json:"iat"`
+}
+
+// LoadSecret reads the hex encoded secret from the given file
+func LoadSecret(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read jwt secret file")
+	}
+	return ParseSecret(string(data))
+}
+
+// ParseSecret decodes the hex encoded secret with or without 0x prefix
+func ParseSecret(hexSecret string) ([]byte, error) {
+	hexSecret = strings.TrimSpace(hexSecret)
+	if !strings.HasPrefix(hexSecret, "0x") {
+		hexSecret = "0x" + hexSecret
+	}
+	secret, err := hexutil.Decode(hexSecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode jwt secret")
+	}
+	if len(secret) != SecretLength {
+		return nil, errors.Wrapf(errInvalidSecretLength, "got %d bytes", len(secret))
+	}
+	return secret, nil
+}
+
+// NewToken creates a token which is issued at the given time and signed with the secret
+func NewToken(secret []byte, issuedAt time.Time) (string, error) {
+	claimsJSON, err := json.Marshal(&claims{IssuedAt: issuedAt.Unix()})
+	if err != nil {
+		return "", err
+	}
+	signingInput := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	return signingInput + "." + sign(secret, signingInput), nil
+}
+
+// VerifyToken checks the signature of the token and that it has been issued around the given time
+func VerifyToken(secret []byte, token string, now time.Time) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return errMalformedToken
+	}
+	var tokenHeader header
+	if err := decodePart(parts[0], &tokenHeader); err != nil {
+		return err
+	}
+	if tokenHeader.Alg != "HS256" {
+		return errors.Wrap(errUnsupportedAlg, tokenHeader.Alg)
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(sign(secret, parts[0]+"."+parts[1]))) {
+		return errInvalidSignature
+	}
+	var tokenClaims claims
+	if err := decodePart(parts[1], &tokenClaims); err != nil {
+		return err
+	}
+	drift := now.Sub(time.Unix(tokenClaims.IssuedAt, 0))
+	if drift > MaxIssuedAtDrift || drift < -MaxIssuedAtDrift {
+		return errors.Wrapf(errStaleToken, "issued at %d", tokenClaims.IssuedAt)
+	}
+	return nil
+}
+
+// sign returns base64url encoded HMAC-SHA256 of the signing input
+func sign(secret []byte, signingInput string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// decodePart decodes base64url encoded json part of the token
+func decodePart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return errors.Wrap(errMalformedToken, err.Error())
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return errors.Wrap(errMalformedToken, err.Error())
+	}
+	return nil
+}
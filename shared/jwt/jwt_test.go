@@ -0,0 +1,52 @@
+package jwt
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/assert"
+	"github.com/lukso-network/lukso-orchestrator/shared/testutil/require"
+)
+
+const testSecret = "0x7365637265747365637265747365637265747365637265747365637265747365"
+
+func TestLoadSecret(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "jwt.hex")
+	require.NoError(t, ioutil.WriteFile(path, []byte(strings.TrimPrefix(testSecret, "0x")+"\n"), 0600))
+	secret, err := LoadSecret(path)
+	require.NoError(t, err)
+	assert.Equal(t, SecretLength, len(secret))
+
+	_, err = LoadSecret(filepath.Join(dir, "missing.hex"))
+	assert.ErrorContains(t, "could not read jwt secret file", err)
+
+	_, err = ParseSecret("0x1234")
+	assert.ErrorContains(t, errInvalidSecretLength.Error(), err)
+	_, err = ParseSecret("not hex")
+	assert.ErrorContains(t, "could not decode jwt secret", err)
+}
+
+func TestNewToken_VerifyToken(t *testing.T) {
+	secret, err := ParseSecret(testSecret)
+	require.NoError(t, err)
+	// issued at claim has seconds precision
+	now := time.Unix(time.Now().Unix(), 0)
+	token, err := NewToken(secret, now)
+	require.NoError(t, err)
+	require.NoError(t, VerifyToken(secret, token, now))
+	require.NoError(t, VerifyToken(secret, token, now.Add(MaxIssuedAtDrift)))
+
+	otherSecret := make([]byte, SecretLength)
+	assert.ErrorContains(t, errInvalidSignature.Error(), VerifyToken(otherSecret, token, now))
+	assert.ErrorContains(t, errStaleToken.Error(), VerifyToken(secret, token, now.Add(MaxIssuedAtDrift+time.Second)))
+	assert.ErrorContains(t, errStaleToken.Error(), VerifyToken(secret, token, now.Add(-MaxIssuedAtDrift-time.Second)))
+	assert.ErrorContains(t, errMalformedToken.Error(), VerifyToken(secret, "token", now))
+
+	parts := strings.Split(token, ".")
+	noneToken := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0." + parts[1] + "." + parts[2]
+	assert.ErrorContains(t, errUnsupportedAlg.Error(), VerifyToken(secret, noneToken, now))
+}
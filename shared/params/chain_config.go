@@ -0,0 +1,4 @@
+package params
+
+// SlotsPerEpoch is the number of vanguard slots in one epoch.
+const SlotsPerEpoch = 32
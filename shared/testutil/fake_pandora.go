@@ -0,0 +1,489 @@
+package testutil
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/lukso-network/lukso-orchestrator/shared/jwt"
+	"github.com/lukso-network/lukso-orchestrator/shared/params"
+	"github.com/lukso-network/lukso-orchestrator/shared/types"
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownFromBlockHash is returned to subscriptions which start from a header the fake node does not know
+var ErrUnknownFromBlockHash = errors.New("unknown from block hash")
+
+// HeaderSigner signs the seal hash of a pandora header on behalf of the slot proposer
+type HeaderSigner func(sealHash common.Hash, proposerIndex uint64) types.BlsSignatureBytes
+
+// DeterministicHeaderSigner derives a 96-byte signature from the seal hash and proposer index. It is not a
+// BLS signature, orchestrator only compares the signature of pandora header with the one of vanguard shard.
+func DeterministicHeaderSigner(sealHash common.Hash, proposerIndex uint64) types.BlsSignatureBytes {
+	var sig types.BlsSignatureBytes
+	index := make([]byte, 8)
+	binary.BigEndian.PutUint64(index, proposerIndex)
+	for i := 0; i < types.BLSSignatureSize/common.HashLength; i++ {
+		part := crypto.Keccak256(sealHash.Bytes(), index, []byte{byte(i)})
+		copy(sig[i*common.HashLength:], part)
+	}
+	return sig
+}
+
+// NewSignedHeader builds the pandora header of the slot on top of the parent header. The extra data carries
+// slot, epoch and proposer index together with the signature of the header's seal hash.
+func NewSignedHeader(parent *eth1Types.Header, slot uint64, signer HeaderSigner) *eth1Types.Header {
+	epoch, proposerIndex := slot/params.SlotsPerEpoch, slot%params.SlotsPerEpoch
+	consensusInfo := NewMinimalConsensusInfo(epoch)
+	header := &eth1Types.Header{
+		ParentHash:  eth1Types.EmptyRootHash,
+		UncleHash:   eth1Types.EmptyUncleHash,
+		Coinbase:    common.HexToAddress("8888f1f195afa192cfee860698584c030f4c9db1"),
+		Root:        common.HexToHash("ef1552a40b7165c3cd773806b9e0c165b75356e0314bf0706f279c729f51e017"),
+		TxHash:      eth1Types.EmptyRootHash,
+		ReceiptHash: eth1Types.EmptyRootHash,
+		Difficulty:  big.NewInt(1),
+		Number:      big.NewInt(1),
+		GasLimit:    uint64(3141592),
+		Time:        consensusInfo.EpochStartTime + proposerIndex*uint64(consensusInfo.SlotTimeDuration),
+		MixDigest:   eth1Types.EmptyRootHash,
+	}
+	if parent != nil {
+		header.ParentHash = parent.Hash()
+		header.Number = new(big.Int).Add(parent.Number, big.NewInt(1))
+	}
+
+	extraData := types.ExtraData{
+		Slot:          slot,
+		Epoch:         epoch,
+		ProposerIndex: proposerIndex,
+	}
+	// the seal hash covers the extra data without signature
+	header.Extra, _ = rlp.EncodeToBytes(extraData)
+	extraDataWithSig := types.PanExtraDataWithBLSSig{
+		ExtraData:         extraData,
+		BlsSignatureBytes: signer(SealHash(header), extraData.ProposerIndex),
+	}
+	header.Extra, _ = rlp.EncodeToBytes(extraDataWithSig)
+	return header
+}
+
+// FakePandoraNode serves the pending headers subscription of pandora over websocket and the header
+// queries over websocket and http. Like pandora, a subscription first sends the stored headers after the
+// filter's FromBlockHash and then the new ones.
+type FakePandoraNode struct {
+	t          testing.TB
+	namespace  string
+	listener   net.Listener
+	httpServer *http.Server
+	signer     HeaderSigner
+
+	lock      sync.Mutex
+	rpcServer *rpc.Server
+	headers   []*eth1Types.Header
+	subs      map[chan *eth1Types.Header]struct{}
+	filters   []types.PandoraPendingHeaderFilter
+	// injected faults
+	subscribeErr error
+	sendDelay    time.Duration
+	skipHistory  bool
+	// highestBlock is reported by eth_syncing while the node is syncing. Zero means synced
+	highestBlock uint64
+	// jwtSecret enables JWT authentication of every http request and websocket handshake
+	jwtSecret    []byte
+	authFailures int
+}
+
+// NewFakePandoraNode starts the fake pandora node with eth namespace on a random local port. It is
+// stopped at the end of the test.
+func NewFakePandoraNode(t testing.TB) *FakePandoraNode {
+	return NewFakePandoraNodeWithNamespace(t, "eth")
+}
+
+// NewFakePandoraNodeWithNamespace starts the fake pandora node which serves its api under the given namespace
+func NewFakePandoraNodeWithNamespace(t testing.TB, namespace string) *FakePandoraNode {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("could not listen for fake pandora node: %v", err)
+	}
+	node := &FakePandoraNode{
+		t:         t,
+		namespace: namespace,
+		listener:  listener,
+		signer:    DeterministicHeaderSigner,
+		subs:      make(map[chan *eth1Types.Header]struct{}),
+	}
+	node.rpcServer = node.newRPCServer()
+	// the handler is looked up per request, so that Disconnect can replace the rpc server
+	node.httpServer = &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !node.authenticate(r) {
+			http.Error(w, "invalid jwt token", http.StatusUnauthorized)
+			return
+		}
+		node.lock.Lock()
+		rpcServer := node.rpcServer
+		node.lock.Unlock()
+		if r.Header.Get("Upgrade") == "websocket" {
+			rpcServer.WebsocketHandler([]string{"*"}).ServeHTTP(w, r)
+			return
+		}
+		rpcServer.ServeHTTP(w, r)
+	})}
+	go func() {
+		_ = node.httpServer.Serve(listener)
+	}()
+	t.Cleanup(node.Stop)
+	return node
+}
+
+// authenticate verifies the bearer token of the request when JWT authentication is enabled
+func (n *FakePandoraNode) authenticate(r *http.Request) bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.jwtSecret == nil {
+		return true
+	}
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if err := jwt.VerifyToken(n.jwtSecret, token, time.Now()); err != nil {
+		n.authFailures++
+		return false
+	}
+	return true
+}
+
+// SetJWTSecret requires a JWT token signed with the secret from the following requests. Nil disables
+// authentication.
+func (n *FakePandoraNode) SetJWTSecret(secret []byte) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.jwtSecret = secret
+}
+
+// AuthFailures returns the number of requests which have been rejected by JWT authentication
+func (n *FakePandoraNode) AuthFailures() int {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.authFailures
+}
+
+// newRPCServer
+func (n *FakePandoraNode) newRPCServer() *rpc.Server {
+	server := rpc.NewServer()
+	if err := server.RegisterName(n.namespace, &fakePandoraAPI{node: n}); err != nil {
+		n.t.Fatalf("could not register fake pandora api: %v", err)
+	}
+	return server
+}
+
+// Endpoint returns the websocket url of the fake node
+func (n *FakePandoraNode) Endpoint() string {
+	return "ws://" + n.listener.Addr().String()
+}
+
+// HTTPEndpoint returns the http url of the fake node. Subscriptions are not supported over http.
+func (n *FakePandoraNode) HTTPEndpoint() string {
+	return "http://" + n.listener.Addr().String()
+}
+
+// Stop closes the listener and all connections
+func (n *FakePandoraNode) Stop() {
+	n.lock.Lock()
+	n.rpcServer.Stop()
+	n.closeSubs()
+	n.lock.Unlock()
+	_ = n.httpServer.Close()
+}
+
+// SetSigner replaces the signer of the headers which are mined afterwards
+func (n *FakePandoraNode) SetSigner(signer HeaderSigner) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.signer = signer
+}
+
+// Headers returns the stored headers in chain order
+func (n *FakePandoraNode) Headers() []*eth1Types.Header {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return append([]*eth1Types.Header{}, n.headers...)
+}
+
+// MineHeader builds the signed header of the slot on top of the latest header and sends it
+func (n *FakePandoraNode) MineHeader(slot uint64) *eth1Types.Header {
+	n.lock.Lock()
+	var parent *eth1Types.Header
+	if len(n.headers) > 0 {
+		parent = n.headers[len(n.headers)-1]
+	}
+	header := NewSignedHeader(parent, slot, n.signer)
+	n.lock.Unlock()
+
+	n.SendHeader(header)
+	return header
+}
+
+// SendHeader stores the header and sends it to the subscribers. It allows sending forks and invalid headers.
+func (n *FakePandoraNode) SendHeader(header *eth1Types.Header) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.headers = append(n.headers, header)
+	for ch := range n.subs {
+		select {
+		case ch <- header:
+		default:
+			// slow subscriber is disconnected
+			delete(n.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// SendMalformedHeader sends a header of the slot whose extra data can not be decoded
+func (n *FakePandoraNode) SendMalformedHeader(slot uint64) *eth1Types.Header {
+	header := NewSignedHeader(nil, slot, DeterministicHeaderSigner)
+	header.Extra = []byte("malformed extra data")
+	n.SendHeader(header)
+	return header
+}
+
+// SetSubscribeError makes the following subscriptions fail with the given error. Nil clears the fault.
+func (n *FakePandoraNode) SetSubscribeError(err error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.subscribeErr = err
+}
+
+// SetSendDelay delays every header notification by the given duration
+func (n *FakePandoraNode) SetSendDelay(delay time.Duration) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.sendDelay = delay
+}
+
+// SetSkipHistory makes the following subscriptions send only the new headers, like a pandora node which
+// does not replay the headers after the filter's from block hash
+func (n *FakePandoraNode) SetSkipHistory(skip bool) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.skipHistory = skip
+}
+
+// SetSyncing makes the node report that it is syncing up to the given highest block. Zero stops syncing.
+func (n *FakePandoraNode) SetSyncing(highestBlock uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.highestBlock = highestBlock
+}
+
+// Disconnect drops all connections of the clients. The node keeps accepting new connections.
+func (n *FakePandoraNode) Disconnect() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.rpcServer.Stop()
+	n.closeSubs()
+	n.rpcServer = n.newRPCServer()
+}
+
+// Subscribers returns the number of active pending header subscriptions
+func (n *FakePandoraNode) Subscribers() int {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return len(n.subs)
+}
+
+// Filters returns the filters of all subscriptions in arrival order
+func (n *FakePandoraNode) Filters() []types.PandoraPendingHeaderFilter {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return append([]types.PandoraPendingHeaderFilter{}, n.filters...)
+}
+
+// closeSubs must be called with the lock held
+func (n *FakePandoraNode) closeSubs() {
+	for ch := range n.subs {
+		delete(n.subs, ch)
+		close(ch)
+	}
+}
+
+// subscribe registers a subscriber and returns the stored headers after the filter's from block hash
+func (n *FakePandoraNode) subscribe(
+	filter types.PandoraPendingHeaderFilter,
+) (chan *eth1Types.Header, []*eth1Types.Header, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.filters = append(n.filters, filter)
+	if n.subscribeErr != nil {
+		return nil, nil, n.subscribeErr
+	}
+
+	start := 0
+	if filter.FromBlockHash != (common.Hash{}) {
+		start = -1
+		for i, header := range n.headers {
+			if header.Hash() == filter.FromBlockHash {
+				start = i + 1
+				break
+			}
+		}
+		if start < 0 {
+			return nil, nil, errors.Wrapf(ErrUnknownFromBlockHash, "%s", filter.FromBlockHash.Hex())
+		}
+	}
+	ch := make(chan *eth1Types.Header, 1024)
+	n.subs[ch] = struct{}{}
+	if n.skipHistory {
+		return ch, nil, nil
+	}
+	return ch, append([]*eth1Types.Header{}, n.headers[start:]...), nil
+}
+
+// unsubscribe
+func (n *FakePandoraNode) unsubscribe(ch chan *eth1Types.Header) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if _, ok := n.subs[ch]; ok {
+		delete(n.subs, ch)
+		close(ch)
+	}
+}
+
+// delay
+func (n *FakePandoraNode) delay() {
+	n.lock.Lock()
+	delay := n.sendDelay
+	n.lock.Unlock()
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+// headerByNumber returns the latest stored header with the given number. Latest and pending return the latest header.
+func (n *FakePandoraNode) headerByNumber(number rpc.BlockNumber) *eth1Types.Header {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if len(n.headers) == 0 {
+		return nil
+	}
+	if number == rpc.LatestBlockNumber || number == rpc.PendingBlockNumber {
+		return n.headers[len(n.headers)-1]
+	}
+	for i := len(n.headers) - 1; i >= 0; i-- {
+		if n.headers[i].Number.Int64() == number.Int64() {
+			return n.headers[i]
+		}
+	}
+	return nil
+}
+
+// headNumber returns the number of the latest stored header
+func (n *FakePandoraNode) headNumber() uint64 {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if len(n.headers) == 0 {
+		return 0
+	}
+	return n.headers[len(n.headers)-1].Number.Uint64()
+}
+
+// headerByHash
+func (n *FakePandoraNode) headerByHash(hash common.Hash) *eth1Types.Header {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for _, header := range n.headers {
+		if header.Hash() == hash {
+			return header
+		}
+	}
+	return nil
+}
+
+// fakePandoraAPI is registered under pandora's namespace
+type fakePandoraAPI struct {
+	node *FakePandoraNode
+}
+
+// NewPendingBlockHeaders
+func (api *fakePandoraAPI) NewPendingBlockHeaders(
+	ctx context.Context,
+	filter types.PandoraPendingHeaderFilter,
+) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	ch, history, err := api.node.subscribe(filter)
+	if err != nil {
+		return nil, err
+	}
+	subscription := notifier.CreateSubscription()
+
+	go func() {
+		defer api.node.unsubscribe(ch)
+		for _, header := range history {
+			api.node.delay()
+			if err := notifier.Notify(subscription.ID, header); err != nil {
+				return
+			}
+		}
+		for {
+			select {
+			case header, ok := <-ch:
+				if !ok {
+					return
+				}
+				api.node.delay()
+				if err := notifier.Notify(subscription.ID, header); err != nil {
+					return
+				}
+			case <-subscription.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return subscription, nil
+}
+
+// GetBlockByNumber returns the header of the block, transactions are never included
+func (api *fakePandoraAPI) GetBlockByNumber(number rpc.BlockNumber, fullTx bool) *eth1Types.Header {
+	return api.node.headerByNumber(number)
+}
+
+// GetBlockByHash returns the header of the block, transactions are never included
+func (api *fakePandoraAPI) GetBlockByHash(hash common.Hash, fullTx bool) *eth1Types.Header {
+	return api.node.headerByHash(hash)
+}
+
+// BlockNumber returns the number of the latest header
+func (api *fakePandoraAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.node.headNumber())
+}
+
+// Syncing returns false when the node is synced, otherwise the sync progress like eth_syncing
+func (api *fakePandoraAPI) Syncing() (interface{}, error) {
+	api.node.lock.Lock()
+	highestBlock := api.node.highestBlock
+	api.node.lock.Unlock()
+	if highestBlock == 0 {
+		return false, nil
+	}
+	return map[string]interface{}{
+		"startingBlock": hexutil.Uint64(0),
+		"currentBlock":  hexutil.Uint64(api.node.headNumber()),
+		"highestBlock":  hexutil.Uint64(highestBlock),
+	}, nil
+}
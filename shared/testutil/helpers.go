@@ -16,7 +16,10 @@ func NewMinimalConsensusInfo(epoch uint64) *types.MinimalEpochConsensusInfoV2 {
 	validatorList := make([]string, 32)
 
 	for idx := 0; idx < 32; idx++ {
+		// compressed bls public key encoding
 		pubKey := make([]byte, 48)
+		pubKey[0] = 0x80
+		pubKey[47] = byte(idx)
 		validatorList[idx] = hexutil.Encode(pubKey)
 	}
 
@@ -25,7 +28,7 @@ func NewMinimalConsensusInfo(epoch uint64) *types.MinimalEpochConsensusInfoV2 {
 	return &types.MinimalEpochConsensusInfoV2{
 		Epoch:            epoch,
 		ValidatorList:    validatorList32[:],
-		EpochStartTime:   765544433,
+		EpochStartTime:   765544433 + epoch*32*6,
 		SlotTimeDuration: time.Duration(6),
 	}
 }
@@ -102,7 +105,13 @@ func NewVanguardShardInfo(slot uint64, header *eth1Types.Header) *types.Vanguard
 	}
 }
 
+// NewPandoraShard returns the sharding info which vanguard builds from the pandora header
 func NewPandoraShard(panHeader *eth1Types.Header) *ethpb.PandoraShard {
+	signature := []byte("df7284286281db4c0bea60b338a62ddfde0d34736ad2657f2bea159fc8c6675cd5bbb68373e9f3d4bba017a82ed0d9b9")
+	var extraDataWithSig types.PanExtraDataWithBLSSig
+	if err := rlp.DecodeBytes(panHeader.Extra, &extraDataWithSig); err == nil {
+		signature = extraDataWithSig.BlsSignatureBytes.Bytes()
+	}
 	return &ethpb.PandoraShard{
 		BlockNumber: panHeader.Number.Uint64(),
 		Hash:        panHeader.Hash().Bytes(),
@@ -110,6 +119,6 @@ func NewPandoraShard(panHeader *eth1Types.Header) *ethpb.PandoraShard {
 		StateRoot:   panHeader.Root.Bytes(),
 		TxHash:      panHeader.TxHash.Bytes(),
 		ReceiptHash: panHeader.ReceiptHash.Bytes(),
-		Signature:   []byte("df7284286281db4c0bea60b338a62ddfde0d34736ad2657f2bea159fc8c6675cd5bbb68373e9f3d4bba017a82ed0d9b9"),
+		Signature:   signature,
 	}
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"github.com/ethereum/go-ethereum/common"
 	eth1Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/lukso-network/lukso-orchestrator/shared/params"
 	eth2Types "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"time"
 )
@@ -15,6 +16,11 @@ type Reorg struct {
 	NewSlot       uint64 `json:"new_slot"`
 }
 
+// Epoch returns the epoch of the reorg slot. Consensus infos after this epoch belong to the abandoned branch.
+func (r *Reorg) Epoch() uint64 {
+	return r.NewSlot / params.SlotsPerEpoch
+}
+
 type MinimalEpochConsensusInfoV2 struct {
 	Epoch            uint64        `json:"epoch"`
 	ValidatorList    []string      `json:"validatorList"`
@@ -103,3 +109,49 @@ func (bls *BlsSignatureBytes) SetBytes(b []byte) {
 
 	copy(bls[BLSSignatureSize-len(b):], b)
 }
+
+// RemovedSlotInfo is a verified slot info which has been removed from db because of a reorg
+type RemovedSlotInfo struct {
+	Slot              uint64      `json:"slot"`
+	VanguardBlockHash common.Hash `json:"vanguardBlockHash"`
+	PandoraHeaderHash common.Hash `json:"pandoraHeaderHash"`
+}
+
+// ReorgRecord is the journal entry which is persisted for every reorg.
+// FromSlot and ToSlot are zero when nothing has been removed.
+type ReorgRecord struct {
+	Epoch        uint64             `json:"epoch"`
+	ReorgInfo    *Reorg             `json:"reorgInfo"`
+	ParentSlot   uint64             `json:"parentSlot"`
+	FromSlot     uint64             `json:"fromSlot"`
+	ToSlot       uint64             `json:"toSlot"`
+	RemovedSlots []*RemovedSlotInfo `json:"removedSlots"`
+	Timestamp    uint64             `json:"timestamp"`
+}
+
+// HighestSlot returns the highest slot which has been affected by the reorg
+func (r *ReorgRecord) HighestSlot() uint64 {
+	if r.ReorgInfo != nil && r.ReorgInfo.NewSlot > r.ToSlot {
+		return r.ReorgInfo.NewSlot
+	}
+	return r.ToSlot
+}
+
+// NewReorgRecord creates the journal entry of a reorg. removedSlots must be sorted in descending slot order.
+func NewReorgRecord(reorgInfo *MinimalEpochConsensusInfoV2, parentSlot uint64, removedSlots []*RemovedSlotInfo) *ReorgRecord {
+	record := &ReorgRecord{
+		Epoch:        reorgInfo.Epoch,
+		ReorgInfo:    reorgInfo.ReorgInfo,
+		ParentSlot:   parentSlot,
+		RemovedSlots: removedSlots,
+		Timestamp:    uint64(time.Now().Unix()),
+	}
+	if record.RemovedSlots == nil {
+		record.RemovedSlots = make([]*RemovedSlotInfo, 0)
+	}
+	if len(removedSlots) > 0 {
+		record.FromSlot = removedSlots[len(removedSlots)-1].Slot
+		record.ToSlot = removedSlots[0].Slot
+	}
+	return record
+}
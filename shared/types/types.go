@@ -2,6 +2,7 @@ package types
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	eth1Types "github.com/ethereum/go-ethereum/core/types"
@@ -46,3 +47,117 @@ func CopyHeader(h *eth1Types.Header) *eth1Types.Header {
 	}
 	return &cpy
 }
+
+// SlotInfoWithSlot keeps slot info together with its slot number for range queries
+type SlotInfoWithSlot struct {
+	Slot uint64
+	SlotInfo
+}
+
+// VanguardSyncStatus describes how far orchestrator lags behind the canonical head of vanguard chain
+type VanguardSyncStatus struct {
+	Syncing            bool   `json:"syncing"`
+	HeadSlot           uint64 `json:"headSlot"`
+	LatestReceivedSlot uint64 `json:"latestReceivedSlot"`
+	LatestVerifiedSlot uint64 `json:"latestVerifiedSlot"`
+	ReceivedDistance   uint64 `json:"receivedDistance"`
+	VerifiedDistance   uint64 `json:"verifiedDistance"`
+	UpdatedAt          uint64 `json:"updatedAt"`
+}
+
+// NewVanguardSyncStatus computes the distances of received and verified slots from the head slot.
+// Orchestrator is syncing when the received slot lags more than maxDistance slots behind.
+func NewVanguardSyncStatus(headSlot, receivedSlot, verifiedSlot, maxDistance uint64) *VanguardSyncStatus {
+	syncStatus := &VanguardSyncStatus{
+		HeadSlot:           headSlot,
+		LatestReceivedSlot: receivedSlot,
+		LatestVerifiedSlot: verifiedSlot,
+		UpdatedAt:          uint64(time.Now().Unix()),
+	}
+	if headSlot > receivedSlot {
+		syncStatus.ReceivedDistance = headSlot - receivedSlot
+	}
+	if headSlot > verifiedSlot {
+		syncStatus.VerifiedDistance = headSlot - verifiedSlot
+	}
+	syncStatus.Syncing = syncStatus.ReceivedDistance > maxDistance
+	return syncStatus
+}
+
+// PandoraSyncState tells whether pandora's head can be trusted to be followed by orchestrator
+type PandoraSyncState string
+
+const (
+	// PandoraSynced means that pandora is not syncing and orchestrator follows its head
+	PandoraSynced PandoraSyncState = "synced"
+	// PandoraSyncing means that pandora itself is syncing with its peers
+	PandoraSyncing PandoraSyncState = "syncing"
+	// PandoraLagging means that the latest verified pandora block lags too far behind pandora's head
+	PandoraLagging PandoraSyncState = "lagging"
+)
+
+// PandoraSyncStatus describes pandora's sync state and how far orchestrator lags behind pandora's head
+type PandoraSyncStatus struct {
+	State                     PandoraSyncState `json:"state"`
+	HeadBlockNumber           uint64           `json:"headBlockNumber"`
+	HighestBlockNumber        uint64           `json:"highestBlockNumber"`
+	LatestReceivedBlockNumber uint64           `json:"latestReceivedBlockNumber"`
+	LatestVerifiedBlockNumber uint64           `json:"latestVerifiedBlockNumber"`
+	ReceivedDistance          uint64           `json:"receivedDistance"`
+	VerifiedDistance          uint64           `json:"verifiedDistance"`
+	UpdatedAt                 uint64           `json:"updatedAt"`
+}
+
+// NewPandoraSyncStatus computes the distances of received and verified blocks from pandora's head block.
+// Highest block is above the head block while pandora is syncing. Orchestrator is lagging when the verified
+// block lags more than maxDistance blocks behind.
+func NewPandoraSyncStatus(headNumber, highestNumber, receivedNumber, verifiedNumber, maxDistance uint64) *PandoraSyncStatus {
+	syncStatus := &PandoraSyncStatus{
+		State:                     PandoraSynced,
+		HeadBlockNumber:           headNumber,
+		HighestBlockNumber:        headNumber,
+		LatestReceivedBlockNumber: receivedNumber,
+		LatestVerifiedBlockNumber: verifiedNumber,
+		UpdatedAt:                 uint64(time.Now().Unix()),
+	}
+	if headNumber > receivedNumber {
+		syncStatus.ReceivedDistance = headNumber - receivedNumber
+	}
+	if headNumber > verifiedNumber {
+		syncStatus.VerifiedDistance = headNumber - verifiedNumber
+	}
+	switch {
+	case highestNumber > headNumber:
+		syncStatus.State = PandoraSyncing
+		syncStatus.HighestBlockNumber = highestNumber
+	case syncStatus.VerifiedDistance > maxDistance:
+		syncStatus.State = PandoraLagging
+	}
+	return syncStatus
+}
+
+// BackfillProgress reports the progress of fetching missed vanguard blocks
+type BackfillProgress struct {
+	Running     bool   `json:"running"`
+	FromSlot    uint64 `json:"fromSlot"`
+	ToSlot      uint64 `json:"toSlot"`
+	CurrentSlot uint64 `json:"currentSlot"`
+	Processed   uint64 `json:"processed"`
+	Error       string `json:"error,omitempty"`
+}
+
+// ConsensusInfoViolation records a minimal consensus info which has been rejected by validation
+type ConsensusInfoViolation struct {
+	Epoch     uint64 `json:"epoch"`
+	Reason    string `json:"reason"`
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// RejectedPandoraHeader records a pandora header which has been rejected by validation on arrival
+type RejectedPandoraHeader struct {
+	HeaderHash  common.Hash `json:"headerHash"`
+	BlockNumber uint64      `json:"blockNumber"`
+	Slot        uint64      `json:"slot"`
+	Reason      string      `json:"reason"`
+	Timestamp   uint64      `json:"timestamp"`
+}